@@ -0,0 +1,25 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+// CommentEditWindow is how long after posting a comment its author may still
+// edit it.
+var CommentEditWindow = 15 * time.Minute
+
+func LoadCommentConfig() {
+	windowStr := os.Getenv("COMMENT_EDIT_WINDOW_MINUTES")
+	if windowStr == "" {
+		return
+	}
+
+	minutes, err := strconv.Atoi(windowStr)
+	if err != nil || minutes < 0 {
+		log.Fatalf("Invalid value for COMMENT_EDIT_WINDOW_MINUTES: %q. Must be a non-negative integer.", windowStr)
+	}
+	CommentEditWindow = time.Duration(minutes) * time.Minute
+}
@@ -56,7 +56,7 @@ func InitDB() *gorm.DB {
 		os.Getenv("DB_PORT"),
 		os.Getenv("DB_NAME"))
 
-	DB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	DB, err = gorm.Open(mysql.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatal("Failed to connect to database: ", err)
 	}
@@ -72,6 +72,31 @@ func AutoMigrate(db *gorm.DB) {
 		&models.Asset{},
 		&models.MaintenanceSchedule{},
 		&models.MaintenanceRecord{},
+		&models.AssetMeter{},
+		&models.MeterReading{},
+		&models.SchedulerLease{},
+		&models.CalendarFeedToken{},
+		&models.TechnicianCategory{},
+		&models.HolidayCalendar{},
+		&models.Holiday{},
+		&models.MaintenanceRecordStatusChange{},
+		&models.ChecklistTemplateItem{},
+		&models.RecordChecklistItem{},
+		&models.Attachment{},
+		&models.StockLocation{},
+		&models.Part{},
+		&models.PartStock{},
+		&models.RecordPart{},
+		&models.LaborEntry{},
+		&models.VendorInvoice{},
+		&models.RecordComment{},
+		&models.RecordCommentMention{},
+		&models.Notification{},
+		&models.CategoryApprovalRule{},
+		&models.RecordApproval{},
+		&models.FailureCode{},
+		&models.WorkRequest{},
+		&models.SLAPolicy{},
 	)
 	if err != nil {
 		log.Fatalf("Failed to auto migrate database: %v", err)
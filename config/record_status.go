@@ -0,0 +1,42 @@
+package config
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"slices"
+
+	"jaga/consts"
+)
+
+// RecordStatusTransitions is the maintenance record state machine: for each
+// status, the statuses a record may move to next.
+var RecordStatusTransitions = consts.DefaultRecordStatusTransitions
+
+// LoadRecordStatusConfig replaces the default transition table with the JSON
+// object in RECORD_STATUS_TRANSITIONS, e.g.
+// {"pending":["in_progress","cancelled"],"in_progress":["finished"]}.
+// Statuses missing from the object are terminal.
+func LoadRecordStatusConfig() {
+	raw := os.Getenv("RECORD_STATUS_TRANSITIONS")
+	if raw == "" {
+		return
+	}
+
+	var transitions map[string][]string
+	if err := json.Unmarshal([]byte(raw), &transitions); err != nil {
+		log.Fatalf("Invalid value for RECORD_STATUS_TRANSITIONS: %v. Must be a JSON object of status lists.", err)
+	}
+
+	for from, targets := range transitions {
+		if !slices.Contains(consts.AllMaintenanceRecordStatuses, from) {
+			log.Fatalf("Invalid value for RECORD_STATUS_TRANSITIONS: unknown status %q.", from)
+		}
+		for _, to := range targets {
+			if !slices.Contains(consts.AllMaintenanceRecordStatuses, to) {
+				log.Fatalf("Invalid value for RECORD_STATUS_TRANSITIONS: unknown status %q.", to)
+			}
+		}
+	}
+	RecordStatusTransitions = transitions
+}
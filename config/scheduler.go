@@ -0,0 +1,52 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+var SchedulerEnabled = true
+var SchedulerInterval = 24 * time.Hour
+
+var LeaderElectionEnabled = true
+var LeaderLeaseDuration = 30 * time.Second
+
+func LoadSchedulerConfig() {
+	if enabledStr := os.Getenv("SCHEDULER_ENABLED"); enabledStr != "" {
+		enabled, err := strconv.ParseBool(enabledStr)
+		if err != nil {
+			log.Fatalf("Invalid value for SCHEDULER_ENABLED: %v. Must be a boolean.", err)
+		}
+		SchedulerEnabled = enabled
+	}
+
+	if enabledStr := os.Getenv("LEADER_ELECTION_ENABLED"); enabledStr != "" {
+		enabled, err := strconv.ParseBool(enabledStr)
+		if err != nil {
+			log.Fatalf("Invalid value for LEADER_ELECTION_ENABLED: %v. Must be a boolean.", err)
+		}
+		LeaderElectionEnabled = enabled
+	}
+
+	if leaseStr := os.Getenv("LEADER_LEASE_SECONDS"); leaseStr != "" {
+		seconds, err := strconv.Atoi(leaseStr)
+		if err != nil || seconds < 3 {
+			log.Fatalf("Invalid value for LEADER_LEASE_SECONDS: %q. Must be an integer of at least 3.", leaseStr)
+		}
+		LeaderLeaseDuration = time.Duration(seconds) * time.Second
+	}
+
+	intervalStr := os.Getenv("SCHEDULER_INTERVAL_MINUTES")
+	if intervalStr == "" {
+		log.Println("SCHEDULER_INTERVAL_MINUTES environment variable not set. Defaulting to 24 hours.")
+		return
+	}
+
+	minutes, err := strconv.Atoi(intervalStr)
+	if err != nil || minutes < 1 {
+		log.Fatalf("Invalid value for SCHEDULER_INTERVAL_MINUTES: %q. Must be a positive integer.", intervalStr)
+	}
+	SchedulerInterval = time.Duration(minutes) * time.Minute
+}
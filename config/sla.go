@@ -0,0 +1,38 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+// SLAEscalationLead is how long before a record's response or resolution
+// deadline the escalation job warns about it.
+var SLAEscalationLead = time.Hour
+
+// SLAEscalationInterval is how often the escalation job runs. It is kept
+// apart from SchedulerInterval so warnings go out within the lead time.
+var SLAEscalationInterval = 15 * time.Minute
+
+func LoadSLAConfig() {
+	if leadStr := os.Getenv("SLA_ESCALATION_LEAD_MINUTES"); leadStr != "" {
+		minutes, err := strconv.Atoi(leadStr)
+		if err != nil || minutes < 0 {
+			log.Fatalf("Invalid value for SLA_ESCALATION_LEAD_MINUTES: %q. Must be a non-negative integer.", leadStr)
+		}
+		SLAEscalationLead = time.Duration(minutes) * time.Minute
+	}
+
+	if intervalStr := os.Getenv("SLA_ESCALATION_INTERVAL_MINUTES"); intervalStr != "" {
+		minutes, err := strconv.Atoi(intervalStr)
+		if err != nil || minutes < 1 {
+			log.Fatalf("Invalid value for SLA_ESCALATION_INTERVAL_MINUTES: %q. Must be a positive integer.", intervalStr)
+		}
+		SLAEscalationInterval = time.Duration(minutes) * time.Minute
+	}
+
+	if SLAEscalationInterval > SLAEscalationLead {
+		log.Printf("SLA escalation interval %s is longer than its lead time %s; some deadlines will only be escalated once missed", SLAEscalationInterval, SLAEscalationLead)
+	}
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+
+	"jaga/storage"
+)
+
+var BlobStore storage.BlobStore
+
+var AttachmentMaxBytes int64 = 20 << 20
+
+// InitBlobStore sets up the blob store selected by STORAGE_DRIVER: "local"
+// (the default) keeps files under STORAGE_LOCAL_DIR, "s3" uses an
+// S3-compatible bucket.
+func InitBlobStore() storage.BlobStore {
+	if maxStr := os.Getenv("ATTACHMENT_MAX_MB"); maxStr != "" {
+		mb, err := strconv.Atoi(maxStr)
+		if err != nil || mb < 1 {
+			log.Fatalf("Invalid value for ATTACHMENT_MAX_MB: %q. Must be a positive integer.", maxStr)
+		}
+		AttachmentMaxBytes = int64(mb) << 20
+	}
+
+	driver := os.Getenv("STORAGE_DRIVER")
+	switch driver {
+	case "", "local":
+		dir := os.Getenv("STORAGE_LOCAL_DIR")
+		if dir == "" {
+			dir = "uploads"
+		}
+		store, err := storage.NewLocalStore(dir)
+		if err != nil {
+			log.Fatalf("Failed to prepare local storage in %q: %v", dir, err)
+		}
+		BlobStore = store
+	case "s3":
+		usePathStyle := true
+		if pathStyleStr := os.Getenv("S3_USE_PATH_STYLE"); pathStyleStr != "" {
+			value, err := strconv.ParseBool(pathStyleStr)
+			if err != nil {
+				log.Fatalf("Invalid value for S3_USE_PATH_STYLE: %v. Must be a boolean.", err)
+			}
+			usePathStyle = value
+		}
+
+		store, err := storage.NewS3Store(storage.S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          os.Getenv("S3_REGION"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			UsePathStyle:    usePathStyle,
+		})
+		if err != nil {
+			log.Fatalf("Failed to configure S3 storage: %v", err)
+		}
+		BlobStore = store
+	default:
+		log.Fatalf("Invalid value for STORAGE_DRIVER: %q. Must be \"local\" or \"s3\".", driver)
+	}
+
+	return BlobStore
+}
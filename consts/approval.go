@@ -0,0 +1,6 @@
+package consts
+
+const (
+	ApprovalDecisionApproved = "approved"
+	ApprovalDecisionRejected = "rejected"
+)
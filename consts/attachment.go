@@ -0,0 +1,44 @@
+package consts
+
+const (
+	AttachmentOwnerAsset       = "asset"
+	AttachmentOwnerRecord      = "maintenance_record"
+	AttachmentOwnerWorkRequest = "work_request"
+)
+
+const (
+	AttachmentKindPhotoBefore = "photo_before"
+	AttachmentKindPhotoAfter  = "photo_after"
+	AttachmentKindInvoice     = "invoice"
+	AttachmentKindManual      = "manual"
+	AttachmentKindSignedForm  = "signed_form"
+	AttachmentKindOther       = "other"
+)
+
+var AllAttachmentKinds = []string{
+	AttachmentKindPhotoBefore,
+	AttachmentKindPhotoAfter,
+	AttachmentKindInvoice,
+	AttachmentKindManual,
+	AttachmentKindSignedForm,
+	AttachmentKindOther,
+}
+
+// AttachmentContentTypes are the content types accepted for uploads, as
+// detected from the file contents.
+var AttachmentContentTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+}
+
+// ThumbnailContentTypes are the image types thumbnails are generated for.
+var ThumbnailContentTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/gif",
+}
+
+const AttachmentThumbnailSize = 256
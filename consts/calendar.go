@@ -0,0 +1,17 @@
+package consts
+
+const (
+	CalendarStatePlanned   = "planned"
+	CalendarStateGenerated = "generated"
+	CalendarStateDone      = "done"
+	CalendarStateMissed    = "missed"
+	CalendarStatePaused    = "paused"
+)
+
+var AllCalendarStates = []string{
+	CalendarStatePlanned,
+	CalendarStateGenerated,
+	CalendarStateDone,
+	CalendarStateMissed,
+	CalendarStatePaused,
+}
@@ -0,0 +1,7 @@
+package consts
+
+const (
+	CostLineLabor  = "labor"
+	CostLinePart   = "part"
+	CostLineVendor = "vendor"
+)
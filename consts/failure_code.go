@@ -0,0 +1,7 @@
+package consts
+
+const (
+	FailureCodeTypeProblem = "problem"
+	FailureCodeTypeCause   = "cause"
+	FailureCodeTypeRemedy  = "remedy"
+)
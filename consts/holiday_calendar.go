@@ -0,0 +1,7 @@
+package consts
+
+// DefaultWorkingDays is the working week of a holiday calendar created
+// without explicit working days.
+const DefaultWorkingDays = "mon,tue,wed,thu,fri"
+
+var AllWeekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
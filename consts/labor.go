@@ -0,0 +1,13 @@
+package consts
+
+const (
+	LaborGroupByRecord     = "record"
+	LaborGroupByAsset      = "asset"
+	LaborGroupByTechnician = "technician"
+)
+
+var AllLaborGroupBys = []string{
+	LaborGroupByRecord,
+	LaborGroupByAsset,
+	LaborGroupByTechnician,
+}
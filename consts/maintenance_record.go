@@ -1,19 +1,45 @@
 package consts
 
 const (
-	RecordStatusPending    = "pending"
-	RecordStatusInProgress = "in_progress"
-	RecordStatusOnHold     = "on_hold"
-	RecordStatusFinished   = "finished"
-	RecordStatusFailed     = "failed"
-	RecordStatusCanceled   = "canceled"
+	RecordStatusPending          = "pending"
+	RecordStatusInProgress       = "in_progress"
+	RecordStatusOnHold           = "on_hold"
+	RecordStatusAwaitingApproval = "awaiting_approval"
+	RecordStatusFinished         = "finished"
+	RecordStatusFailed           = "failed"
+	RecordStatusCancelled        = "cancelled"
 )
 
 var AllMaintenanceRecordStatuses = []string{
 	RecordStatusPending,
 	RecordStatusInProgress,
 	RecordStatusOnHold,
+	RecordStatusAwaitingApproval,
 	RecordStatusFinished,
 	RecordStatusFailed,
-	RecordStatusCanceled,
+	RecordStatusCancelled,
+}
+
+var OpenMaintenanceRecordStatuses = []string{
+	RecordStatusPending,
+	RecordStatusInProgress,
+	RecordStatusOnHold,
+	RecordStatusAwaitingApproval,
+}
+
+// DefaultRecordStatusTransitions lists, for each record status, the statuses a
+// record may move to next. Cancelled is terminal; a failed record can be
+// reopened. Only finishing a record whose category asks for sign-off moves it
+// to awaiting_approval; clients cannot request that status. A record awaiting
+// approval is finished by a manager's approval or sent back to in_progress. Parts are taken out of stock when a record
+// finishes, so cancelling a finished record reverses it and puts its parts
+// back.
+var DefaultRecordStatusTransitions = map[string][]string{
+	RecordStatusPending:          {RecordStatusInProgress, RecordStatusOnHold, RecordStatusCancelled},
+	RecordStatusInProgress:       {RecordStatusOnHold, RecordStatusAwaitingApproval, RecordStatusFinished, RecordStatusFailed, RecordStatusCancelled},
+	RecordStatusOnHold:           {RecordStatusInProgress, RecordStatusCancelled},
+	RecordStatusAwaitingApproval: {RecordStatusFinished, RecordStatusInProgress, RecordStatusCancelled},
+	RecordStatusFinished:         {RecordStatusCancelled},
+	RecordStatusFailed:           {RecordStatusPending},
+	RecordStatusCancelled:        {},
 }
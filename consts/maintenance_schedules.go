@@ -5,7 +5,41 @@ const (
 	ScheduleTypeConditional = "conditional"
 )
 
+const (
+	ScheduleAdvanceFromDueDate    = "due_date"
+	ScheduleAdvanceFromCompletion = "completion_date"
+)
+
+var AllScheduleAdvanceModes = []string{
+	ScheduleAdvanceFromDueDate,
+	ScheduleAdvanceFromCompletion,
+}
+
 var AllMaintenanceScheduleStatuses = []string{
 	ScheduleTypePeriodic,
 	ScheduleTypeConditional,
 }
+
+const (
+	ClosedDayPolicyShiftForward  = "shift_forward"
+	ClosedDayPolicyShiftBackward = "shift_backward"
+	ClosedDayPolicyKeep          = "keep"
+)
+
+var AllClosedDayPolicies = []string{
+	ClosedDayPolicyShiftForward,
+	ClosedDayPolicyShiftBackward,
+	ClosedDayPolicyKeep,
+}
+
+const (
+	ResumePolicySkipMissed        = "skip_missed"
+	ResumePolicyRestartFromResume = "restart_from_resume"
+	ResumePolicyKeep              = "keep"
+)
+
+var AllResumePolicies = []string{
+	ResumePolicySkipMissed,
+	ResumePolicyRestartFromResume,
+	ResumePolicyKeep,
+}
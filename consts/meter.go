@@ -0,0 +1,13 @@
+package consts
+
+const (
+	MeterTypeRunHours   = "run_hours"
+	MeterTypeOdometer   = "odometer"
+	MeterTypeCycleCount = "cycle_count"
+)
+
+var AllMeterTypes = []string{
+	MeterTypeRunHours,
+	MeterTypeOdometer,
+	MeterTypeCycleCount,
+}
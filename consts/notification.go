@@ -0,0 +1,18 @@
+package consts
+
+const (
+	NotificationTypeMention             = "mention"
+	NotificationTypeApprovalRequested   = "approval_requested"
+	NotificationTypeApprovalApproved    = "approval_approved"
+	NotificationTypeApprovalRejected    = "approval_rejected"
+	NotificationTypeWorkRequestTriaged  = "work_request_triaged"
+	NotificationTypeWorkRequestMerged   = "work_request_merged"
+	NotificationTypeWorkRequestRejected = "work_request_rejected"
+	NotificationTypeSLAAtRisk           = "sla_at_risk"
+	NotificationTypeSLABreached         = "sla_breached"
+)
+
+const (
+	NotificationResourceRecord      = "maintenance_record"
+	NotificationResourceWorkRequest = "work_request"
+)
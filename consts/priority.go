@@ -0,0 +1,21 @@
+package consts
+
+const (
+	PriorityLow      = "low"
+	PriorityMedium   = "medium"
+	PriorityHigh     = "high"
+	PriorityCritical = "critical"
+)
+
+var AllPriorities = []string{
+	PriorityLow,
+	PriorityMedium,
+	PriorityHigh,
+	PriorityCritical,
+}
+
+// Sort keys of the maintenance record list besides its plain columns.
+const (
+	RecordSortPriority    = "priority"
+	RecordSortSLADeadline = "sla_deadline"
+)
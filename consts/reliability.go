@@ -0,0 +1,6 @@
+package consts
+
+const (
+	ReliabilityGroupByAsset    = "asset"
+	ReliabilityGroupByCategory = "category"
+)
@@ -0,0 +1,15 @@
+package consts
+
+const (
+	WorkRequestStatusOpen     = "open"
+	WorkRequestStatusTriaged  = "triaged"
+	WorkRequestStatusMerged   = "merged"
+	WorkRequestStatusRejected = "rejected"
+)
+
+const (
+	WorkRequestUrgencyLow      = "low"
+	WorkRequestUrgencyMedium   = "medium"
+	WorkRequestUrgencyHigh     = "high"
+	WorkRequestUrgencyCritical = "critical"
+)
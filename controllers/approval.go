@@ -0,0 +1,218 @@
+package controllers
+
+import (
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ApprovalController interface {
+	GetCategoryApprovalRule(c *gin.Context)
+	SetCategoryApprovalRule(c *gin.Context)
+	GetRecordApprovals(c *gin.Context)
+	ApproveMaintenanceRecord(c *gin.Context)
+	RejectMaintenanceRecord(c *gin.Context)
+}
+
+type approvalController struct {
+	service       services.ApprovalService
+	recordService services.MaintenanceRecordService
+}
+
+func NewApprovalController(service services.ApprovalService, recordService services.MaintenanceRecordService) ApprovalController {
+	return &approvalController{service: service, recordService: recordService}
+}
+
+func toCategoryApprovalRuleDTO(rule *models.CategoryApprovalRule) dto.CategoryApprovalRuleDTO {
+	return dto.CategoryApprovalRuleDTO{
+		CategoryID:       rule.CategoryID,
+		RequiresApproval: rule.RequiresApproval,
+		UnscheduledOnly:  rule.UnscheduledOnly,
+	}
+}
+
+// GetCategoryApprovalRule godoc
+// @Summary Get a category's approval rule
+// @Description Get which finished records of assets in a category need a manager's sign-off. Categories without a rule need none.
+// @Tags Approvals
+// @Produce json
+// @Param id path string true "Asset Category ID"
+// @Success 200 {object} dto.CategoryApprovalRuleResponse
+// @Router /v1/asset-categories/{id}/approval-rule [get]
+func (ctrl *approvalController) GetCategoryApprovalRule(c *gin.Context) {
+	rule, err := ctrl.service.GetCategoryApprovalRule(c.Param("id"))
+	if err != nil {
+		if err.Error() == "asset category not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Asset category not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve approval rule: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CategoryApprovalRuleResponse{
+		Message: "Approval rule retrieved successfully",
+		Rule:    toCategoryApprovalRuleDTO(rule),
+	})
+}
+
+// SetCategoryApprovalRule godoc
+// @Summary Set a category's approval rule
+// @Description Replace the approval rule of an asset category. With requires_approval, records a technician finishes go to awaiting_approval until a manager signs them off; unscheduled_only limits this to records without a schedule. Records already awaiting approval still need it.
+// @Tags Approvals
+// @Accept json
+// @Produce json
+// @Param id path string true "Asset Category ID"
+// @Param request body dto.SetCategoryApprovalRuleRequest true "Set Category Approval Rule Request"
+// @Success 200 {object} dto.CategoryApprovalRuleResponse
+// @Router /v1/asset-categories/{id}/approval-rule [put]
+func (ctrl *approvalController) SetCategoryApprovalRule(c *gin.Context) {
+	var req dto.SetCategoryApprovalRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	rule := &models.CategoryApprovalRule{
+		CategoryID:       c.Param("id"),
+		RequiresApproval: req.RequiresApproval,
+		UnscheduledOnly:  req.UnscheduledOnly,
+	}
+	if err := ctrl.service.SetCategoryApprovalRule(rule); err != nil {
+		if err.Error() == "asset category not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Asset category not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update approval rule: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CategoryApprovalRuleResponse{
+		Message: "Approval rule updated successfully",
+		Rule:    toCategoryApprovalRuleDTO(rule),
+	})
+}
+
+// GetRecordApprovals godoc
+// @Summary Get the approval decisions of a maintenance record
+// @Description List every approval and rejection of a maintenance record, oldest first, with who decided and why
+// @Tags Approvals
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Success 200 {object} dto.GetRecordApprovalsResponse
+// @Router /v1/maintenance-records/{id}/approvals [get]
+func (ctrl *approvalController) GetRecordApprovals(c *gin.Context) {
+	approvals, err := ctrl.service.GetRecordApprovals(c.Param("id"))
+	if err != nil {
+		if err.Error() == "maintenance record not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Maintenance record not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve approvals: " + err.Error()})
+		return
+	}
+
+	approvalDTOs := make([]dto.RecordApprovalDTO, len(approvals))
+	for i, approval := range approvals {
+		approvalDTOs[i] = dto.RecordApprovalDTO{
+			ID:          approval.ID,
+			Decision:    approval.Decision,
+			Reason:      approval.Reason,
+			DecidedBy:   approval.DecidedBy,
+			DeciderName: approval.Decider.Name,
+			DecidedAt:   approval.DecidedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.GetRecordApprovalsResponse{
+		Message:   "Approvals retrieved successfully",
+		Approvals: approvalDTOs,
+	})
+}
+
+// ApproveMaintenanceRecord godoc
+// @Summary Approve a maintenance record
+// @Description Sign off a maintenance record awaiting approval. The record finishes: its parts are taken out of stock, its schedule advances and the technician is notified. Nobody may approve their own work.
+// @Tags Approvals
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param request body dto.ApproveMaintenanceRecordRequest false "Approve Maintenance Record Request"
+// @Success 200 {object} dto.ApprovalDecisionResponse
+// @Router /v1/maintenance-records/{id}/approve [post]
+func (ctrl *approvalController) ApproveMaintenanceRecord(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ApproveMaintenanceRecordRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+			return
+		}
+	}
+
+	if err := ctrl.recordService.ApproveMaintenanceRecord(c.Param("id"), userID, req.Comment); err != nil {
+		switch err.Error() {
+		case "maintenance record not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record cannot be approved by its performer":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case "maintenance record is not awaiting approval", "invalid status transition",
+			"required checklist items are incomplete", "insufficient stock":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to approve maintenance record: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ApprovalDecisionResponse{
+		Message: "Maintenance record approved successfully",
+	})
+}
+
+// RejectMaintenanceRecord godoc
+// @Summary Reject a maintenance record
+// @Description Send a maintenance record awaiting approval back to in_progress. The technician is notified with the reason.
+// @Tags Approvals
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param request body dto.RejectMaintenanceRecordRequest true "Reject Maintenance Record Request"
+// @Success 200 {object} dto.ApprovalDecisionResponse
+// @Router /v1/maintenance-records/{id}/reject [post]
+func (ctrl *approvalController) RejectMaintenanceRecord(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RejectMaintenanceRecordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	if err := ctrl.recordService.RejectMaintenanceRecord(c.Param("id"), userID, req.Reason); err != nil {
+		switch err.Error() {
+		case "maintenance record not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is not awaiting approval", "invalid status transition":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reject maintenance record: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ApprovalDecisionResponse{
+		Message: "Maintenance record rejected successfully",
+	})
+}
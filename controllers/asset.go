@@ -48,6 +48,7 @@ func (ctrl *AssetController) CreateAsset(c *gin.Context) {
 		CategoryID:          req.CategoryID,
 		Location:            req.Location,
 		PurchaseDate:        req.PurchaseDate,
+		PurchaseCost:        req.PurchaseCost,
 		LastMaintenanceDate: req.LastMaintenanceDate,
 		Condition:           req.Condition,
 		Status:              req.Status,
@@ -102,9 +103,12 @@ func (ctrl *AssetController) GetAssetByID(c *gin.Context) {
 		CategoryName:        assetModel.Category.Name,
 		Location:            assetModel.Location,
 		PurchaseDate:        assetModel.PurchaseDate,
+		PurchaseCost:        assetModel.PurchaseCost,
 		LastMaintenanceDate: assetModel.LastMaintenanceDate,
 		Condition:           assetModel.Condition,
 		Status:              assetModel.Status,
+		StatusReason:        assetModel.StatusReason,
+		StatusChangedAt:     assetModel.StatusChangedAt,
 		AddedBy:             assetModel.AddedBy,
 		CreatedAt:           assetModel.CreatedAt,
 		UpdatedAt:           assetModel.UpdatedAt,
@@ -155,9 +159,12 @@ func (ctrl *AssetController) GetAssets(c *gin.Context) {
 			CategoryName:        asset.Category.Name,
 			Location:            asset.Location,
 			PurchaseDate:        asset.PurchaseDate,
+			PurchaseCost:        asset.PurchaseCost,
 			LastMaintenanceDate: asset.LastMaintenanceDate,
 			Condition:           asset.Condition,
 			Status:              asset.Status,
+			StatusReason:        asset.StatusReason,
+			StatusChangedAt:     asset.StatusChangedAt,
 			AddedBy:             asset.AddedBy,
 			CreatedAt:           asset.CreatedAt,
 			UpdatedAt:           asset.UpdatedAt,
@@ -204,6 +211,7 @@ func (ctrl *AssetController) UpdateAsset(c *gin.Context) {
 		CategoryID:          req.CategoryID,
 		Location:            req.Location,
 		PurchaseDate:        req.PurchaseDate,
+		PurchaseCost:        req.PurchaseCost,
 		LastMaintenanceDate: req.LastMaintenanceDate,
 		Condition:           req.Condition,
 		Status:              req.Status,
@@ -248,7 +256,7 @@ func (ctrl *AssetController) UpdateAssetStatus(c *gin.Context) {
 		return
 	}
 
-	err := ctrl.AssetService.UpdateAssetStatus(assetID, req.Status)
+	err := ctrl.AssetService.UpdateAssetStatus(assetID, req.Status, req.Reason)
 	if err != nil {
 		if err.Error() == "asset not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -0,0 +1,221 @@
+package controllers
+
+import (
+	"math"
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AssetMeterController interface {
+	CreateAssetMeter(c *gin.Context)
+	GetAssetMeters(c *gin.Context)
+	CreateMeterReading(c *gin.Context)
+	GetMeterReadings(c *gin.Context)
+}
+
+type assetMeterController struct {
+	service services.AssetMeterService
+}
+
+func NewAssetMeterController(service services.AssetMeterService) AssetMeterController {
+	return &assetMeterController{service: service}
+}
+
+func toAssetMeterDTO(meter models.AssetMeter) dto.AssetMeterDTO {
+	return dto.AssetMeterDTO{
+		ID:            meter.ID,
+		AssetID:       meter.AssetID,
+		MeterType:     meter.MeterType,
+		Name:          meter.Name,
+		Unit:          meter.Unit,
+		CurrentValue:  meter.CurrentValue,
+		LastReadingAt: meter.LastReadingAt,
+		CreatedAt:     meter.CreatedAt,
+		UpdatedAt:     meter.UpdatedAt,
+	}
+}
+
+// CreateAssetMeter godoc
+// @Summary Add a meter to an asset
+// @Description Register a usage meter (run hours, odometer or cycle count) on an asset
+// @Tags AssetMeters
+// @Accept json
+// @Produce json
+// @Param id path string true "Asset ID"
+// @Param request body dto.CreateAssetMeterRequest true "Create Asset Meter Request"
+// @Success 201 {object} dto.CreateAssetMeterResponse
+// @Router /v1/assets/{id}/meters [post]
+func (ctrl *assetMeterController) CreateAssetMeter(c *gin.Context) {
+	assetID := c.Param("id")
+	var req dto.CreateAssetMeterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	meter := &models.AssetMeter{
+		AssetID:      assetID,
+		MeterType:    req.MeterType,
+		Name:         req.Name,
+		Unit:         req.Unit,
+		CurrentValue: req.InitialValue,
+	}
+
+	if err := ctrl.service.CreateAssetMeter(meter); err != nil {
+		if err.Error() == "asset not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Asset not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create meter: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateAssetMeterResponse{
+		Message: "Meter created successfully",
+		Meter:   toAssetMeterDTO(*meter),
+	})
+}
+
+// GetAssetMeters godoc
+// @Summary Get the meters of an asset
+// @Description Retrieve every usage meter registered on an asset
+// @Tags AssetMeters
+// @Produce json
+// @Param id path string true "Asset ID"
+// @Success 200 {object} dto.GetAssetMetersResponse
+// @Router /v1/assets/{id}/meters [get]
+func (ctrl *assetMeterController) GetAssetMeters(c *gin.Context) {
+	assetID := c.Param("id")
+
+	meters, err := ctrl.service.GetAssetMeters(assetID)
+	if err != nil {
+		if err.Error() == "asset not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Asset not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve meters: " + err.Error()})
+		return
+	}
+
+	meterDTOs := make([]dto.AssetMeterDTO, len(meters))
+	for i, meter := range meters {
+		meterDTOs[i] = toAssetMeterDTO(meter)
+	}
+
+	c.JSON(http.StatusOK, dto.GetAssetMetersResponse{
+		Message: "Meters retrieved successfully",
+		Meters:  meterDTOs,
+	})
+}
+
+// CreateMeterReading godoc
+// @Summary Record a meter reading
+// @Description Record a new reading and trigger conditional schedules whose threshold it crosses. The reading is saved together with the records it triggers, or not at all
+// @Tags AssetMeters
+// @Accept json
+// @Produce json
+// @Param id path string true "Asset ID"
+// @Param meterId path string true "Meter ID"
+// @Param request body dto.CreateMeterReadingRequest true "Create Meter Reading Request"
+// @Success 201 {object} dto.CreateMeterReadingResponse
+// @Router /v1/assets/{id}/meters/{meterId}/readings [post]
+func (ctrl *assetMeterController) CreateMeterReading(c *gin.Context) {
+	assetID := c.Param("id")
+	var req dto.CreateMeterReadingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	recordedBy, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	reading := &models.MeterReading{
+		MeterID:    c.Param("meterId"),
+		Value:      req.Value,
+		RecordedBy: recordedBy.(string),
+	}
+	if req.ReadAt != nil {
+		reading.ReadAt = *req.ReadAt
+	}
+
+	triggered, err := ctrl.service.RecordMeterReading(assetID, reading)
+	if err != nil {
+		if err.Error() == "meter not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Meter not found"})
+			return
+		}
+		if err.Error() == "meter reading is lower than the current value" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record meter reading: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateMeterReadingResponse{
+		Message:          "Meter reading recorded successfully",
+		TriggeredRecords: triggered,
+	})
+}
+
+// GetMeterReadings godoc
+// @Summary Get meter readings
+// @Description Retrieve a paginated list of readings for a meter, newest first
+// @Tags AssetMeters
+// @Produce json
+// @Param id path string true "Asset ID"
+// @Param meterId path string true "Meter ID"
+// @Success 200 {object} dto.GetMeterReadingsResponse
+// @Router /v1/assets/{id}/meters/{meterId}/readings [get]
+func (ctrl *assetMeterController) GetMeterReadings(c *gin.Context) {
+	var req dto.GetMeterReadingsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	readings, totalItems, err := ctrl.service.GetMeterReadings(c.Param("id"), c.Param("meterId"), req.Page, req.ItemsPerPage)
+	if err != nil {
+		if err.Error() == "meter not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Meter not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve meter readings: " + err.Error()})
+		return
+	}
+
+	readingDTOs := make([]dto.MeterReadingDTO, len(readings))
+	for i, reading := range readings {
+		readingDTOs[i] = dto.MeterReadingDTO{
+			ID:         reading.ID,
+			MeterID:    reading.MeterID,
+			Value:      reading.Value,
+			ReadAt:     reading.ReadAt,
+			RecordedBy: reading.RecordedBy,
+			CreatedAt:  reading.CreatedAt,
+		}
+	}
+
+	totalPages := 0
+	if req.ItemsPerPage > 0 {
+		totalPages = int(math.Ceil(float64(totalItems) / float64(req.ItemsPerPage)))
+	}
+
+	c.JSON(http.StatusOK, dto.GetMeterReadingsResponse{
+		Message:      "Meter readings retrieved successfully",
+		Readings:     readingDTOs,
+		TotalItems:   int(totalItems),
+		Page:         req.Page,
+		ItemsPerPage: req.ItemsPerPage,
+		TotalPages:   totalPages,
+	})
+}
@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AssignmentController interface {
+	GetTechnicianCategories(c *gin.Context)
+	SetTechnicianCategories(c *gin.Context)
+	RebalanceMaintenanceRecords(c *gin.Context)
+}
+
+type assignmentController struct {
+	service services.AssignmentService
+}
+
+func NewAssignmentController(service services.AssignmentService) AssignmentController {
+	return &assignmentController{service: service}
+}
+
+func toTechnicianCategoryDTOs(categories []models.TechnicianCategory) []dto.TechnicianCategoryDTO {
+	categoryDTOs := make([]dto.TechnicianCategoryDTO, len(categories))
+	for i, category := range categories {
+		categoryDTOs[i] = dto.TechnicianCategoryDTO{
+			CategoryID:   category.CategoryID,
+			CategoryName: category.Category.Name,
+		}
+	}
+	return categoryDTOs
+}
+
+// GetTechnicianCategories godoc
+// @Summary Get a technician's categories
+// @Description List the asset categories a technician is preferred for when work is assigned automatically
+// @Tags Assignment
+// @Produce json
+// @Param id path string true "Technician user ID"
+// @Success 200 {object} dto.GetTechnicianCategoriesResponse
+// @Router /v1/users/{id}/categories [get]
+func (ctrl *assignmentController) GetTechnicianCategories(c *gin.Context) {
+	categories, err := ctrl.service.GetTechnicianCategories(c.Param("id"))
+	if err != nil {
+		if err.Error() == "technician not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Technician not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve technician categories: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetTechnicianCategoriesResponse{
+		Message:    "Technician categories retrieved successfully",
+		Categories: toTechnicianCategoryDTOs(categories),
+	})
+}
+
+// SetTechnicianCategories godoc
+// @Summary Set a technician's categories
+// @Description Replace the asset categories a technician is preferred for when work is assigned automatically
+// @Tags Assignment
+// @Accept json
+// @Produce json
+// @Param id path string true "Technician user ID"
+// @Param request body dto.SetTechnicianCategoriesRequest true "Set Technician Categories Request"
+// @Success 200 {object} dto.GetTechnicianCategoriesResponse
+// @Router /v1/users/{id}/categories [put]
+func (ctrl *assignmentController) SetTechnicianCategories(c *gin.Context) {
+	var req dto.SetTechnicianCategoriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	categories, err := ctrl.service.SetTechnicianCategories(c.Param("id"), req.CategoryIDs)
+	if err != nil {
+		if err.Error() == "technician not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Technician not found"})
+			return
+		}
+		if err.Error() == "asset category not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Asset category not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update technician categories: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetTechnicianCategoriesResponse{
+		Message:    "Technician categories updated successfully",
+		Categories: toTechnicianCategoryDTOs(categories),
+	})
+}
+
+// RebalanceMaintenanceRecords godoc
+// @Summary Rebalance open maintenance records
+// @Description Reassign pending maintenance records across technicians by open-record load and category affinity. Only pending records are moved; records in progress or on hold stay with their technician but count towards the load. The reassignments are saved together or not at all.
+// @Tags Assignment
+// @Produce json
+// @Param dry_run query bool false "Only report the resulting load"
+// @Success 200 {object} dto.RebalanceMaintenanceRecordsResponse
+// @Router /v1/maintenance-records/rebalance [post]
+func (ctrl *assignmentController) RebalanceMaintenanceRecords(c *gin.Context) {
+	var req dto.RebalanceMaintenanceRecordsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	report, err := ctrl.service.RebalanceOpenRecords(req.DryRun)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to rebalance maintenance records: " + err.Error()})
+		return
+	}
+
+	loadDTOs := make([]dto.TechnicianLoadDTO, len(report.Technicians))
+	for i, load := range report.Technicians {
+		loadDTOs[i] = dto.TechnicianLoadDTO{
+			UserID: load.Technician.ID,
+			Name:   load.Technician.Name,
+			Before: load.Before,
+			After:  load.After,
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.RebalanceMaintenanceRecordsResponse{
+		Message:     "Maintenance records rebalanced successfully",
+		DryRun:      req.DryRun,
+		Reassigned:  report.Reassigned,
+		Technicians: loadDTOs,
+	})
+}
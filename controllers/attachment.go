@@ -0,0 +1,345 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+
+	"jaga/consts"
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead leaves room for the multipart framing and form fields
+// around the file itself.
+const multipartOverhead = 1 << 20
+
+type AttachmentController interface {
+	UploadAssetAttachment(c *gin.Context)
+	GetAssetAttachments(c *gin.Context)
+	UploadMaintenanceRecordAttachment(c *gin.Context)
+	GetMaintenanceRecordAttachments(c *gin.Context)
+	UploadWorkRequestAttachment(c *gin.Context)
+	GetWorkRequestAttachments(c *gin.Context)
+	DownloadAttachment(c *gin.Context)
+	GetAttachmentThumbnail(c *gin.Context)
+	DeleteAttachment(c *gin.Context)
+}
+
+type attachmentController struct {
+	service  services.AttachmentService
+	maxBytes int64
+}
+
+func NewAttachmentController(service services.AttachmentService, maxBytes int64) AttachmentController {
+	return &attachmentController{service: service, maxBytes: maxBytes}
+}
+
+func toAttachmentDTO(attachment models.Attachment) dto.AttachmentDTO {
+	var thumbnailURL *string
+	if attachment.ThumbnailKey != nil {
+		url := fmt.Sprintf("/v1/attachments/%s/thumbnail", attachment.ID)
+		thumbnailURL = &url
+	}
+	return dto.AttachmentDTO{
+		ID:             attachment.ID,
+		OwnerType:      attachment.OwnerType,
+		OwnerID:        attachment.OwnerID,
+		Kind:           attachment.Kind,
+		FileName:       attachment.FileName,
+		ContentType:    attachment.ContentType,
+		Size:           attachment.Size,
+		DownloadURL:    fmt.Sprintf("/v1/attachments/%s/download", attachment.ID),
+		ThumbnailURL:   thumbnailURL,
+		UploadedBy:     attachment.UploadedBy,
+		UploadedByName: attachment.Uploader.Name,
+		CreatedAt:      attachment.CreatedAt,
+	}
+}
+
+// UploadAssetAttachment godoc
+// @Summary Upload an asset attachment
+// @Description Attach a photo, invoice, manual or signed form to an asset. Accepts JPEG, PNG, GIF, WebP and PDF files up to the configured size limit; the type is detected from the file contents.
+// @Tags Attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Asset ID"
+// @Param file formData file true "File to upload"
+// @Param kind formData string false "photo_before, photo_after, invoice, manual, signed_form or other"
+// @Success 201 {object} dto.UploadAttachmentResponse
+// @Router /v1/assets/{id}/attachments [post]
+func (ctrl *attachmentController) UploadAssetAttachment(c *gin.Context) {
+	ctrl.upload(c, consts.AttachmentOwnerAsset)
+}
+
+// GetAssetAttachments godoc
+// @Summary Get asset attachments
+// @Description List the files attached to an asset
+// @Tags Attachments
+// @Produce json
+// @Param id path string true "Asset ID"
+// @Success 200 {object} dto.GetAttachmentsResponse
+// @Router /v1/assets/{id}/attachments [get]
+func (ctrl *attachmentController) GetAssetAttachments(c *gin.Context) {
+	ctrl.list(c, consts.AttachmentOwnerAsset)
+}
+
+// UploadMaintenanceRecordAttachment godoc
+// @Summary Upload a maintenance record attachment
+// @Description Attach a photo, invoice, manual or signed form to a maintenance record. Accepts JPEG, PNG, GIF, WebP and PDF files up to the configured size limit; the type is detected from the file contents. Technicians may only upload to records assigned to them or unassigned records.
+// @Tags Attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param file formData file true "File to upload"
+// @Param kind formData string false "photo_before, photo_after, invoice, manual, signed_form or other"
+// @Success 201 {object} dto.UploadAttachmentResponse
+// @Router /v1/maintenance-records/{id}/attachments [post]
+func (ctrl *attachmentController) UploadMaintenanceRecordAttachment(c *gin.Context) {
+	ctrl.upload(c, consts.AttachmentOwnerRecord)
+}
+
+// GetMaintenanceRecordAttachments godoc
+// @Summary Get maintenance record attachments
+// @Description List the files attached to a maintenance record. Technicians only see files of records assigned to them or unassigned records.
+// @Tags Attachments
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Success 200 {object} dto.GetAttachmentsResponse
+// @Router /v1/maintenance-records/{id}/attachments [get]
+func (ctrl *attachmentController) GetMaintenanceRecordAttachments(c *gin.Context) {
+	ctrl.list(c, consts.AttachmentOwnerRecord)
+}
+
+// UploadWorkRequestAttachment godoc
+// @Summary Upload a work request attachment
+// @Description Attach a photo of the problem to a work request. Accepts JPEG, PNG, GIF, WebP and PDF files up to the configured size limit; the type is detected from the file contents. Technicians may only upload to requests they can see.
+// @Tags Attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Work Request ID"
+// @Param file formData file true "File to upload"
+// @Param kind formData string false "photo_before, photo_after, invoice, manual, signed_form or other"
+// @Success 201 {object} dto.UploadAttachmentResponse
+// @Router /v1/work-requests/{id}/attachments [post]
+func (ctrl *attachmentController) UploadWorkRequestAttachment(c *gin.Context) {
+	ctrl.upload(c, consts.AttachmentOwnerWorkRequest)
+}
+
+// GetWorkRequestAttachments godoc
+// @Summary Get work request attachments
+// @Description List the files attached to a work request. Technicians only see files of the requests they submitted or that became records assigned to them.
+// @Tags Attachments
+// @Produce json
+// @Param id path string true "Work Request ID"
+// @Success 200 {object} dto.GetAttachmentsResponse
+// @Router /v1/work-requests/{id}/attachments [get]
+func (ctrl *attachmentController) GetWorkRequestAttachments(c *gin.Context) {
+	ctrl.list(c, consts.AttachmentOwnerWorkRequest)
+}
+
+// DownloadAttachment godoc
+// @Summary Download an attachment
+// @Description Download the file of an attachment, subject to the same access rules as listing it
+// @Tags Attachments
+// @Produce octet-stream
+// @Param id path string true "Attachment ID"
+// @Success 200 {file} file
+// @Router /v1/attachments/{id}/download [get]
+func (ctrl *attachmentController) DownloadAttachment(c *gin.Context) {
+	ctrl.serve(c, false)
+}
+
+// GetAttachmentThumbnail godoc
+// @Summary Get an attachment thumbnail
+// @Description Get the JPEG thumbnail of an image attachment
+// @Tags Attachments
+// @Produce jpeg
+// @Param id path string true "Attachment ID"
+// @Success 200 {file} file
+// @Router /v1/attachments/{id}/thumbnail [get]
+func (ctrl *attachmentController) GetAttachmentThumbnail(c *gin.Context) {
+	ctrl.serve(c, true)
+}
+
+// DeleteAttachment godoc
+// @Summary Delete an attachment
+// @Description Delete an attachment and its files. Technicians may only delete files they uploaded.
+// @Tags Attachments
+// @Produce json
+// @Param id path string true "Attachment ID"
+// @Success 200 {object} dto.DeleteAttachmentResponse
+// @Router /v1/attachments/{id} [delete]
+func (ctrl *attachmentController) DeleteAttachment(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := ctrl.service.DeleteAttachment(c.Param("id"), userID, role); err != nil {
+		if err.Error() == "attachment not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Attachment not found"})
+			return
+		}
+		if err.Error() == "access to attachment denied" {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete attachment: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeleteAttachmentResponse{
+		Message: "Attachment deleted successfully",
+	})
+}
+
+func (ctrl *attachmentController) upload(c *gin.Context, ownerType string) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, ctrl.maxBytes+multipartOverhead)
+
+	var req dto.UploadAttachmentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		if isBodyTooLarge(err) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "file is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		if isBodyTooLarge(err) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "file is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A file is required in the \"file\" field"})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file: " + err.Error()})
+		return
+	}
+	defer file.Close()
+
+	attachment, err := ctrl.service.UploadAttachment(ownerType, c.Param("id"), req.Kind, fileHeader.Filename, file, userID, role)
+	if err != nil {
+		switch err.Error() {
+		case "asset not found", "maintenance record not found", "work request not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is assigned to another technician", "access to work request denied":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case "file is too large":
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case "file type is not allowed":
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		case "file is empty":
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upload attachment: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.UploadAttachmentResponse{
+		Message:    "Attachment uploaded successfully",
+		Attachment: toAttachmentDTO(*attachment),
+	})
+}
+
+func (ctrl *attachmentController) list(c *gin.Context, ownerType string) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	attachments, err := ctrl.service.GetAttachments(ownerType, c.Param("id"), userID, role)
+	if err != nil {
+		switch err.Error() {
+		case "asset not found", "maintenance record not found", "work request not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is assigned to another technician", "access to work request denied":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve attachments: " + err.Error()})
+		}
+		return
+	}
+
+	attachmentDTOs := make([]dto.AttachmentDTO, len(attachments))
+	for i, attachment := range attachments {
+		attachmentDTOs[i] = toAttachmentDTO(attachment)
+	}
+
+	c.JSON(http.StatusOK, dto.GetAttachmentsResponse{
+		Message:     "Attachments retrieved successfully",
+		Attachments: attachmentDTOs,
+	})
+}
+
+func (ctrl *attachmentController) serve(c *gin.Context, thumbnail bool) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	attachment, reader, err := ctrl.service.OpenAttachment(c.Param("id"), thumbnail, userID, role)
+	if err != nil {
+		switch err.Error() {
+		case "attachment not found", "thumbnail not found", "attachment file not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is assigned to another technician", "access to work request denied":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open attachment: " + err.Error()})
+		}
+		return
+	}
+	defer reader.Close()
+
+	disposition := "attachment"
+	contentType := attachment.ContentType
+	size := attachment.Size
+	if thumbnail {
+		disposition = "inline"
+		contentType = "image/jpeg"
+		size = -1
+	}
+
+	c.DataFromReader(http.StatusOK, size, contentType, reader, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType(disposition, map[string]string{"filename": attachment.FileName}),
+		"X-Content-Type-Options": "nosniff",
+		"Cache-Control":          "private, max-age=3600",
+	})
+}
+
+// actorFromContext returns the authenticated user's ID and role, answering
+// with an error when either is missing.
+func actorFromContext(c *gin.Context) (string, string, bool) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return "", "", false
+	}
+	role, exists := c.Get("role")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Role not found in context"})
+		return "", "", false
+	}
+	return userID.(string), role.(string), true
+}
+
+func isBodyTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CalendarFeedController interface {
+	CreateCalendarFeedToken(c *gin.Context)
+	GetCalendarFeedTokens(c *gin.Context)
+	RevokeCalendarFeedToken(c *gin.Context)
+	GetCalendarFeed(c *gin.Context)
+}
+
+type calendarFeedController struct {
+	service services.CalendarFeedService
+}
+
+func NewCalendarFeedController(service services.CalendarFeedService) CalendarFeedController {
+	return &calendarFeedController{service: service}
+}
+
+func toCalendarFeedTokenDTO(token models.CalendarFeedToken) dto.CalendarFeedTokenDTO {
+	return dto.CalendarFeedTokenDTO{
+		ID:         token.ID,
+		Name:       token.Name,
+		LastUsedAt: token.LastUsedAt,
+		RevokedAt:  token.RevokedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
+
+// CreateCalendarFeedToken godoc
+// @Summary Create a calendar feed token
+// @Description Issue a token for subscribing to your maintenance work from a calendar app. The feed URL is only shown once.
+// @Tags CalendarFeeds
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateCalendarFeedTokenRequest false "Create Calendar Feed Token Request"
+// @Success 201 {object} dto.CreateCalendarFeedTokenResponse
+// @Router /v1/me/calendar-feed-tokens [post]
+func (ctrl *calendarFeedController) CreateCalendarFeedToken(c *gin.Context) {
+	var req dto.CreateCalendarFeedTokenRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+			return
+		}
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	token, plain, err := ctrl.service.CreateFeedToken(userID.(string), req.Name)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create calendar feed token: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateCalendarFeedTokenResponse{
+		Message: "Calendar feed token created successfully",
+		Token:   toCalendarFeedTokenDTO(*token),
+		FeedURL: feedURL(c, plain),
+	})
+}
+
+// GetCalendarFeedTokens godoc
+// @Summary List calendar feed tokens
+// @Description List your calendar feed tokens, including revoked ones
+// @Tags CalendarFeeds
+// @Produce json
+// @Success 200 {object} dto.GetCalendarFeedTokensResponse
+// @Router /v1/me/calendar-feed-tokens [get]
+func (ctrl *calendarFeedController) GetCalendarFeedTokens(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	tokens, err := ctrl.service.GetFeedTokens(userID.(string))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve calendar feed tokens: " + err.Error()})
+		return
+	}
+
+	tokenDTOs := make([]dto.CalendarFeedTokenDTO, len(tokens))
+	for i, token := range tokens {
+		tokenDTOs[i] = toCalendarFeedTokenDTO(token)
+	}
+
+	c.JSON(http.StatusOK, dto.GetCalendarFeedTokensResponse{
+		Message: "Calendar feed tokens retrieved successfully",
+		Tokens:  tokenDTOs,
+	})
+}
+
+// RevokeCalendarFeedToken godoc
+// @Summary Revoke a calendar feed token
+// @Description Revoke one of your calendar feed tokens; subscriptions using it stop working immediately
+// @Tags CalendarFeeds
+// @Produce json
+// @Param id path string true "Token ID"
+// @Success 200 {object} dto.RevokeCalendarFeedTokenResponse
+// @Router /v1/me/calendar-feed-tokens/{id} [delete]
+func (ctrl *calendarFeedController) RevokeCalendarFeedToken(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	if err := ctrl.service.RevokeFeedToken(userID.(string), c.Param("id")); err != nil {
+		if err.Error() == "calendar feed token not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Calendar feed token not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke calendar feed token: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RevokeCalendarFeedTokenResponse{
+		Message: "Calendar feed token revoked successfully",
+	})
+}
+
+// GetCalendarFeed godoc
+// @Summary Get a calendar feed
+// @Description iCalendar feed of the token owner's assigned schedules and open maintenance records. Authenticated by the token in the URL instead of a JWT so calendar apps can subscribe to it.
+// @Tags CalendarFeeds
+// @Produce text/calendar
+// @Param token path string true "Calendar feed token"
+// @Success 200 {string} string
+// @Router /v1/calendar-feeds/{token}/maintenance.ics [get]
+func (ctrl *calendarFeedController) GetCalendarFeed(c *gin.Context) {
+	feed, err := ctrl.service.BuildFeed(c.Param("token"), time.Now())
+	if err != nil {
+		if err.Error() == "calendar feed not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Calendar feed not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build calendar feed: " + err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Header("Content-Disposition", `inline; filename="maintenance.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(feed))
+}
+
+func feedURL(c *gin.Context, token string) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host + "/v1/calendar-feeds/" + token + "/maintenance.ics"
+}
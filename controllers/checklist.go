@@ -0,0 +1,290 @@
+package controllers
+
+import (
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChecklistController interface {
+	GetScheduleChecklist(c *gin.Context)
+	SetScheduleChecklist(c *gin.Context)
+	GetCategoryChecklist(c *gin.Context)
+	SetCategoryChecklist(c *gin.Context)
+	GetRecordChecklist(c *gin.Context)
+	UpdateRecordChecklistItem(c *gin.Context)
+}
+
+type checklistController struct {
+	service services.ChecklistService
+}
+
+func NewChecklistController(service services.ChecklistService) ChecklistController {
+	return &checklistController{service: service}
+}
+
+func toChecklistTemplateItemDTOs(items []models.ChecklistTemplateItem) []dto.ChecklistTemplateItemDTO {
+	itemDTOs := make([]dto.ChecklistTemplateItemDTO, len(items))
+	for i, item := range items {
+		itemDTOs[i] = dto.ChecklistTemplateItemDTO{
+			ID:          item.ID,
+			Position:    item.Position,
+			Title:       item.Title,
+			Description: item.Description,
+			Required:    item.Required,
+			Unit:        item.Unit,
+		}
+	}
+	return itemDTOs
+}
+
+func toRecordChecklistItemDTO(item models.RecordChecklistItem) dto.RecordChecklistItemDTO {
+	var completedByName *string
+	if item.Completer != nil {
+		completedByName = &item.Completer.Name
+	}
+	return dto.RecordChecklistItemDTO{
+		ID:              item.ID,
+		Position:        item.Position,
+		Title:           item.Title,
+		Description:     item.Description,
+		Required:        item.Required,
+		Unit:            item.Unit,
+		Completed:       item.CompletedAt != nil,
+		CompletedAt:     item.CompletedAt,
+		CompletedBy:     item.CompletedBy,
+		CompletedByName: completedByName,
+		Note:            item.Note,
+		MeasuredValue:   item.MeasuredValue,
+	}
+}
+
+// toChecklistTemplateItems maps the requested steps to template items. Steps
+// are required unless marked otherwise.
+func toChecklistTemplateItems(req dto.SetChecklistRequest) []models.ChecklistTemplateItem {
+	items := make([]models.ChecklistTemplateItem, len(req.Items))
+	for i, item := range req.Items {
+		required := true
+		if item.Required != nil {
+			required = *item.Required
+		}
+		items[i] = models.ChecklistTemplateItem{
+			Title:       item.Title,
+			Description: item.Description,
+			Required:    required,
+			Unit:        item.Unit,
+		}
+	}
+	return items
+}
+
+// GetScheduleChecklist godoc
+// @Summary Get a schedule's checklist
+// @Description List the ordered checklist steps copied onto records generated from a maintenance schedule
+// @Tags Checklists
+// @Produce json
+// @Param id path string true "Maintenance Schedule ID"
+// @Success 200 {object} dto.GetChecklistResponse
+// @Router /v1/maintenance-schedules/{id}/checklist [get]
+func (ctrl *checklistController) GetScheduleChecklist(c *gin.Context) {
+	items, err := ctrl.service.GetScheduleChecklist(c.Param("id"))
+	if err != nil {
+		if err.Error() == "maintenance schedule not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Maintenance schedule not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve checklist: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetChecklistResponse{
+		Message: "Checklist retrieved successfully",
+		Items:   toChecklistTemplateItemDTOs(items),
+	})
+}
+
+// SetScheduleChecklist godoc
+// @Summary Set a schedule's checklist
+// @Description Replace the checklist of a maintenance schedule. Steps keep the order given. Records already generated keep their own copy.
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Schedule ID"
+// @Param request body dto.SetChecklistRequest true "Set Checklist Request"
+// @Success 200 {object} dto.GetChecklistResponse
+// @Router /v1/maintenance-schedules/{id}/checklist [put]
+func (ctrl *checklistController) SetScheduleChecklist(c *gin.Context) {
+	var req dto.SetChecklistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	items, err := ctrl.service.SetScheduleChecklist(c.Param("id"), toChecklistTemplateItems(req))
+	if err != nil {
+		if err.Error() == "maintenance schedule not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Maintenance schedule not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update checklist: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetChecklistResponse{
+		Message: "Checklist updated successfully",
+		Items:   toChecklistTemplateItemDTOs(items),
+	})
+}
+
+// GetCategoryChecklist godoc
+// @Summary Get a category's checklist
+// @Description List the ordered checklist steps copied onto records of assets in a category whose schedule has no checklist of its own
+// @Tags Checklists
+// @Produce json
+// @Param id path string true "Asset Category ID"
+// @Success 200 {object} dto.GetChecklistResponse
+// @Router /v1/asset-categories/{id}/checklist [get]
+func (ctrl *checklistController) GetCategoryChecklist(c *gin.Context) {
+	items, err := ctrl.service.GetCategoryChecklist(c.Param("id"))
+	if err != nil {
+		if err.Error() == "asset category not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Asset category not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve checklist: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetChecklistResponse{
+		Message: "Checklist retrieved successfully",
+		Items:   toChecklistTemplateItemDTOs(items),
+	})
+}
+
+// SetCategoryChecklist godoc
+// @Summary Set a category's checklist
+// @Description Replace the checklist of an asset category. Steps keep the order given. Records already created keep their own copy.
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path string true "Asset Category ID"
+// @Param request body dto.SetChecklistRequest true "Set Checklist Request"
+// @Success 200 {object} dto.GetChecklistResponse
+// @Router /v1/asset-categories/{id}/checklist [put]
+func (ctrl *checklistController) SetCategoryChecklist(c *gin.Context) {
+	var req dto.SetChecklistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	items, err := ctrl.service.SetCategoryChecklist(c.Param("id"), toChecklistTemplateItems(req))
+	if err != nil {
+		if err.Error() == "asset category not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Asset category not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update checklist: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetChecklistResponse{
+		Message: "Checklist updated successfully",
+		Items:   toChecklistTemplateItemDTOs(items),
+	})
+}
+
+// GetRecordChecklist godoc
+// @Summary Get a record's checklist
+// @Description List the checklist steps of a maintenance record and their completion
+// @Tags Checklists
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Success 200 {object} dto.GetRecordChecklistResponse
+// @Router /v1/maintenance-records/{id}/checklist [get]
+func (ctrl *checklistController) GetRecordChecklist(c *gin.Context) {
+	items, err := ctrl.service.GetRecordChecklist(c.Param("id"))
+	if err != nil {
+		if err.Error() == "maintenance record not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Maintenance record not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve checklist: " + err.Error()})
+		return
+	}
+
+	itemDTOs := make([]dto.RecordChecklistItemDTO, len(items))
+	for i, item := range items {
+		itemDTOs[i] = toRecordChecklistItemDTO(item)
+	}
+
+	c.JSON(http.StatusOK, dto.GetRecordChecklistResponse{
+		Message: "Checklist retrieved successfully",
+		Items:   itemDTOs,
+	})
+}
+
+// UpdateRecordChecklistItem godoc
+// @Summary Update a checklist step of a record
+// @Description Tick a checklist step of an open maintenance record off, or untick it, with an optional note and measured value. Technicians may only update records assigned to them or unassigned records.
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param itemId path string true "Checklist Item ID"
+// @Param request body dto.UpdateRecordChecklistItemRequest true "Update Checklist Item Request"
+// @Success 200 {object} dto.UpdateRecordChecklistItemResponse
+// @Router /v1/maintenance-records/{id}/checklist/{itemId} [put]
+func (ctrl *checklistController) UpdateRecordChecklistItem(c *gin.Context) {
+	var req dto.UpdateRecordChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	role, exists := c.Get("role")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Role not found in context"})
+		return
+	}
+
+	item, err := ctrl.service.UpdateRecordChecklistItem(
+		c.Param("id"),
+		c.Param("itemId"),
+		userID.(string),
+		role.(string),
+		req.Completed,
+		req.Note,
+		req.MeasuredValue,
+	)
+	if err != nil {
+		if err.Error() == "maintenance record not found" || err.Error() == "checklist item not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "maintenance record is assigned to another technician" {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "maintenance record is closed" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update checklist item: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UpdateRecordChecklistItemResponse{
+		Message: "Checklist item updated successfully",
+		Item:    toRecordChecklistItemDTO(*item),
+	})
+}
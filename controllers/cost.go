@@ -0,0 +1,309 @@
+package controllers
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CostController interface {
+	GetRecordCosts(c *gin.Context)
+	GetVendorInvoices(c *gin.Context)
+	CreateVendorInvoice(c *gin.Context)
+	DeleteVendorInvoice(c *gin.Context)
+	GetAssetCostSummary(c *gin.Context)
+	GetCategoryCostRollup(c *gin.Context)
+}
+
+type costController struct {
+	service services.CostService
+}
+
+func NewCostController(service services.CostService) CostController {
+	return &costController{service: service}
+}
+
+// roundMoney rounds an amount to cents.
+func roundMoney(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func toVendorInvoiceDTO(invoice models.VendorInvoice) dto.VendorInvoiceDTO {
+	return dto.VendorInvoiceDTO{
+		ID:            invoice.ID,
+		RecordID:      invoice.RecordID,
+		Vendor:        invoice.Vendor,
+		InvoiceNumber: invoice.InvoiceNumber,
+		InvoiceDate:   invoice.InvoiceDate,
+		Amount:        invoice.Amount,
+		Description:   invoice.Description,
+		AddedBy:       invoice.AddedBy,
+		CreatedAt:     invoice.CreatedAt,
+	}
+}
+
+func toMaintenanceCostsDTO(costs repositories.MaintenanceCosts) dto.MaintenanceCostsDTO {
+	return dto.MaintenanceCostsDTO{
+		RecordCount:     costs.RecordCount,
+		LaborHours:      toHours(costs.LaborSeconds),
+		LaborCost:       roundMoney(costs.LaborCost),
+		PartsCost:       roundMoney(costs.PartsCost),
+		VendorCost:      roundMoney(costs.VendorCost),
+		MaintenanceCost: roundMoney(costs.LaborCost + costs.PartsCost + costs.VendorCost),
+	}
+}
+
+// GetRecordCosts godoc
+// @Summary Get maintenance record costs
+// @Description List the cost lines of a maintenance record: stopped labor at each user's hourly rate when it was logged, parts at their unit cost when added (left out for cancelled records) and vendor invoices
+// @Tags Costs
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Success 200 {object} dto.GetRecordCostsResponse
+// @Router /v1/maintenance-records/{id}/costs [get]
+func (ctrl *costController) GetRecordCosts(c *gin.Context) {
+	costs, err := ctrl.service.GetRecordCosts(c.Param("id"))
+	if err != nil {
+		if err.Error() == "maintenance record not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve maintenance record costs: " + err.Error()})
+		return
+	}
+
+	lineDTOs := make([]dto.CostLineDTO, len(costs.Lines))
+	for i, line := range costs.Lines {
+		lineDTOs[i] = dto.CostLineDTO{
+			Type:        line.Type,
+			ReferenceID: line.ReferenceID,
+			Description: line.Description,
+			Quantity:    line.Quantity,
+			UnitCost:    line.UnitCost,
+			Amount:      roundMoney(line.Amount),
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.GetRecordCostsResponse{
+		Message:    "Maintenance record costs retrieved successfully",
+		Lines:      lineDTOs,
+		LaborCost:  roundMoney(costs.LaborCost),
+		PartsCost:  roundMoney(costs.PartsCost),
+		VendorCost: roundMoney(costs.VendorCost),
+		TotalCost:  roundMoney(costs.LaborCost + costs.PartsCost + costs.VendorCost),
+	})
+}
+
+// GetVendorInvoices godoc
+// @Summary Get vendor invoices
+// @Description List the external vendor invoices charged to a maintenance record
+// @Tags Costs
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Success 200 {object} dto.GetVendorInvoicesResponse
+// @Router /v1/maintenance-records/{id}/vendor-invoices [get]
+func (ctrl *costController) GetVendorInvoices(c *gin.Context) {
+	invoices, err := ctrl.service.GetVendorInvoices(c.Param("id"))
+	if err != nil {
+		if err.Error() == "maintenance record not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve vendor invoices: " + err.Error()})
+		return
+	}
+
+	invoiceDTOs := make([]dto.VendorInvoiceDTO, len(invoices))
+	for i, invoice := range invoices {
+		invoiceDTOs[i] = toVendorInvoiceDTO(invoice)
+	}
+
+	c.JSON(http.StatusOK, dto.GetVendorInvoicesResponse{
+		Message:        "Vendor invoices retrieved successfully",
+		VendorInvoices: invoiceDTOs,
+	})
+}
+
+// CreateVendorInvoice godoc
+// @Summary Add a vendor invoice
+// @Description Charge an external vendor's invoice to a maintenance record
+// @Tags Costs
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param request body dto.CreateVendorInvoiceRequest true "Create Vendor Invoice Request"
+// @Success 201 {object} dto.CreateVendorInvoiceResponse
+// @Router /v1/maintenance-records/{id}/vendor-invoices [post]
+func (ctrl *costController) CreateVendorInvoice(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateVendorInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	invoiceDate, _ := time.Parse("2006-01-02", req.InvoiceDate)
+	invoice := &models.VendorInvoice{
+		RecordID:      c.Param("id"),
+		Vendor:        req.Vendor,
+		InvoiceNumber: req.InvoiceNumber,
+		InvoiceDate:   invoiceDate,
+		Amount:        req.Amount,
+		Description:   req.Description,
+		AddedBy:       userID,
+	}
+
+	if err := ctrl.service.CreateVendorInvoice(invoice); err != nil {
+		if err.Error() == "maintenance record not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create vendor invoice: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateVendorInvoiceResponse{
+		Message:       "Vendor invoice created successfully",
+		VendorInvoice: toVendorInvoiceDTO(*invoice),
+	})
+}
+
+// DeleteVendorInvoice godoc
+// @Summary Delete a vendor invoice
+// @Description Remove a vendor invoice from a maintenance record
+// @Tags Costs
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param invoiceId path string true "Vendor Invoice ID"
+// @Success 200 {object} dto.DeleteVendorInvoiceResponse
+// @Router /v1/maintenance-records/{id}/vendor-invoices/{invoiceId} [delete]
+func (ctrl *costController) DeleteVendorInvoice(c *gin.Context) {
+	if err := ctrl.service.DeleteVendorInvoice(c.Param("id"), c.Param("invoiceId")); err != nil {
+		if err.Error() == "vendor invoice not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete vendor invoice: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeleteVendorInvoiceResponse{
+		Message: "Vendor invoice deleted successfully",
+	})
+}
+
+// GetAssetCostSummary godoc
+// @Summary Get an asset's cost summary
+// @Description Total what an asset has cost: its purchase cost plus the labor, parts and vendor costs of its maintenance records. With a date range, both inclusive, only records dated within it count, and the purchase cost only when the asset was bought within it.
+// @Tags Costs
+// @Produce json
+// @Param id path string true "Asset ID"
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.GetAssetCostSummaryResponse
+// @Router /v1/assets/{id}/cost-summary [get]
+func (ctrl *costController) GetAssetCostSummary(c *gin.Context) {
+	var req dto.GetAssetCostSummaryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	var to *time.Time
+	if req.To != nil {
+		end := req.To.AddDate(0, 0, 1)
+		to = &end
+	}
+
+	summary, err := ctrl.service.GetAssetCostSummary(c.Param("id"), req.From, to)
+	if err != nil {
+		if err.Error() == "asset not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "report start must be before its end" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve asset cost summary: " + err.Error()})
+		return
+	}
+
+	maintenance := toMaintenanceCostsDTO(summary.Maintenance)
+	c.JSON(http.StatusOK, dto.GetAssetCostSummaryResponse{
+		Message: "Asset cost summary retrieved successfully",
+		From:    req.From,
+		To:      req.To,
+		CostSummary: dto.AssetCostSummaryDTO{
+			AssetID:      summary.Asset.ID,
+			AssetName:    summary.Asset.Name,
+			CategoryID:   summary.Asset.CategoryID,
+			PurchaseDate: summary.Asset.PurchaseDate,
+			PurchaseCost: roundMoney(summary.PurchaseCost),
+			Maintenance:  maintenance,
+			TotalCost:    roundMoney(summary.PurchaseCost + maintenance.MaintenanceCost),
+		},
+	})
+}
+
+// GetCategoryCostRollup godoc
+// @Summary Get a cost rollup per asset category
+// @Description Total per asset category the purchase cost of the assets bought between two dates, both inclusive, and the labor, parts and vendor costs of the maintenance records dated within them
+// @Tags Reports
+// @Produce json
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string true "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.GetCategoryCostRollupResponse
+// @Router /v1/reports/category-costs [get]
+func (ctrl *costController) GetCategoryCostRollup(c *gin.Context) {
+	var req dto.GetCategoryCostRollupRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	rollup, err := ctrl.service.GetCategoryCostRollup(req.From, req.To.AddDate(0, 0, 1))
+	if err != nil {
+		if err.Error() == "report start must be before its end" || err.Error() == "report range is too long" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve category costs: " + err.Error()})
+		return
+	}
+
+	var totalCost float64
+	categoryDTOs := make([]dto.CategoryCostDTO, len(rollup))
+	for i, category := range rollup {
+		maintenance := toMaintenanceCostsDTO(category.Maintenance)
+		categoryTotal := roundMoney(category.Purchases.PurchaseCost + maintenance.MaintenanceCost)
+		totalCost += categoryTotal
+		categoryDTOs[i] = dto.CategoryCostDTO{
+			CategoryID:      category.Category.ID,
+			CategoryName:    category.Category.Name,
+			AssetsPurchased: category.Purchases.AssetCount,
+			PurchaseCost:    roundMoney(category.Purchases.PurchaseCost),
+			Maintenance:     maintenance,
+			TotalCost:       categoryTotal,
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.GetCategoryCostRollupResponse{
+		Message:    "Category costs retrieved successfully",
+		From:       req.From,
+		To:         req.To,
+		Categories: categoryDTOs,
+		TotalCost:  roundMoney(totalCost),
+	})
+}
@@ -0,0 +1,252 @@
+package controllers
+
+import (
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FailureCodeController interface {
+	CreateFailureCode(c *gin.Context)
+	GetFailureCodes(c *gin.Context)
+	GetFailureCodeByID(c *gin.Context)
+	UpdateFailureCode(c *gin.Context)
+	DeleteFailureCode(c *gin.Context)
+	SetRecordFailureCodes(c *gin.Context)
+}
+
+type failureCodeController struct {
+	service services.FailureCodeService
+}
+
+func NewFailureCodeController(service services.FailureCodeService) FailureCodeController {
+	return &failureCodeController{service: service}
+}
+
+func toFailureCodeDTO(code models.FailureCode) dto.FailureCodeDTO {
+	return dto.FailureCodeDTO{
+		ID:          code.ID,
+		Type:        code.Type,
+		Code:        code.Code,
+		Name:        code.Name,
+		Description: code.Description,
+		Active:      code.Active,
+		CreatedAt:   code.CreatedAt,
+		UpdatedAt:   code.UpdatedAt,
+	}
+}
+
+// CreateFailureCode godoc
+// @Summary Create a failure code
+// @Description Add a problem, cause or remedy code to the failure taxonomy. Codes are unique per type.
+// @Tags FailureCodes
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateFailureCodeRequest true "Create Failure Code Request"
+// @Success 201 {object} dto.CreateFailureCodeResponse
+// @Router /v1/failure-codes [post]
+func (ctrl *failureCodeController) CreateFailureCode(c *gin.Context) {
+	var req dto.CreateFailureCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	code := &models.FailureCode{
+		Type:        req.Type,
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	if err := ctrl.service.CreateFailureCode(code); err != nil {
+		if err.Error() == "failure code already exists" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create failure code: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateFailureCodeResponse{
+		Message:     "Failure code created successfully",
+		FailureCode: toFailureCodeDTO(*code),
+	})
+}
+
+// GetFailureCodes godoc
+// @Summary Get failure codes
+// @Description Retrieve the failure taxonomy, optionally of one type. Deactivated codes are left out unless include_inactive is set.
+// @Tags FailureCodes
+// @Produce json
+// @Param type query string false "problem, cause or remedy"
+// @Param include_inactive query bool false "Include deactivated codes"
+// @Success 200 {object} dto.GetFailureCodesResponse
+// @Router /v1/failure-codes [get]
+func (ctrl *failureCodeController) GetFailureCodes(c *gin.Context) {
+	var req dto.GetFailureCodesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	codes, err := ctrl.service.GetFailureCodes(req.Type, req.IncludeInactive)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve failure codes: " + err.Error()})
+		return
+	}
+
+	codeDTOs := make([]dto.FailureCodeDTO, len(codes))
+	for i, code := range codes {
+		codeDTOs[i] = toFailureCodeDTO(code)
+	}
+
+	c.JSON(http.StatusOK, dto.GetFailureCodesResponse{
+		Message:      "Failure codes retrieved successfully",
+		FailureCodes: codeDTOs,
+	})
+}
+
+// GetFailureCodeByID godoc
+// @Summary Get a failure code
+// @Description Retrieve a failure code by its ID
+// @Tags FailureCodes
+// @Produce json
+// @Param id path string true "Failure Code ID"
+// @Success 200 {object} dto.GetFailureCodeByIDResponse
+// @Router /v1/failure-codes/{id} [get]
+func (ctrl *failureCodeController) GetFailureCodeByID(c *gin.Context) {
+	code, err := ctrl.service.GetFailureCodeByID(c.Param("id"))
+	if err != nil {
+		if err.Error() == "failure code not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Failure code not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve failure code: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetFailureCodeByIDResponse{
+		Message:     "Failure code retrieved successfully",
+		FailureCode: toFailureCodeDTO(*code),
+	})
+}
+
+// UpdateFailureCode godoc
+// @Summary Update a failure code
+// @Description Change a failure code's code, name or description, or deactivate it so it can no longer be put on records. Its type cannot change.
+// @Tags FailureCodes
+// @Accept json
+// @Produce json
+// @Param id path string true "Failure Code ID"
+// @Param request body dto.UpdateFailureCodeRequest true "Update Failure Code Request"
+// @Success 200 {object} dto.UpdateFailureCodeResponse
+// @Router /v1/failure-codes/{id} [put]
+func (ctrl *failureCodeController) UpdateFailureCode(c *gin.Context) {
+	var req dto.UpdateFailureCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	code := &models.FailureCode{
+		ID:          c.Param("id"),
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	if err := ctrl.service.UpdateFailureCode(code, req.Active); err != nil {
+		if err.Error() == "failure code not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Failure code not found"})
+			return
+		}
+		if err.Error() == "failure code already exists" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update failure code: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UpdateFailureCodeResponse{
+		Message:     "Failure code updated successfully",
+		FailureCode: toFailureCodeDTO(*code),
+	})
+}
+
+// DeleteFailureCode godoc
+// @Summary Delete a failure code
+// @Description Delete a failure code no maintenance record carries. Codes in use can only be deactivated.
+// @Tags FailureCodes
+// @Produce json
+// @Param id path string true "Failure Code ID"
+// @Success 200 {object} dto.DeleteFailureCodeResponse
+// @Router /v1/failure-codes/{id} [delete]
+func (ctrl *failureCodeController) DeleteFailureCode(c *gin.Context) {
+	if err := ctrl.service.DeleteFailureCode(c.Param("id")); err != nil {
+		if err.Error() == "failure code not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Failure code not found"})
+			return
+		}
+		if err.Error() == "failure code is in use" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete failure code: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeleteFailureCodeResponse{
+		Message: "Failure code deleted successfully",
+	})
+}
+
+// SetRecordFailureCodes godoc
+// @Summary Classify a maintenance record's failure
+// @Description Set the problem, cause and remedy codes of a maintenance record. An omitted code is kept and an empty one is cleared. Records with a problem code count as failures in the reliability report. Technicians may only classify records assigned to them.
+// @Tags FailureCodes
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param request body dto.SetRecordFailureCodesRequest true "Set Record Failure Codes Request"
+// @Success 200 {object} dto.SetRecordFailureCodesResponse
+// @Router /v1/maintenance-records/{id}/failure-codes [put]
+func (ctrl *failureCodeController) SetRecordFailureCodes(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.SetRecordFailureCodesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	record, err := ctrl.service.SetRecordFailureCodes(c.Param("id"), req.ProblemCodeID, req.CauseCodeID, req.RemedyCodeID, userID, role)
+	if err != nil {
+		switch err.Error() {
+		case "maintenance record not found", "failure code not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is assigned to another technician":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case "failure code type does not match", "failure code is inactive":
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update failure codes: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SetRecordFailureCodesResponse{
+		Message:       "Failure codes updated successfully",
+		ProblemCodeID: record.ProblemCodeID,
+		CauseCodeID:   record.CauseCodeID,
+		RemedyCodeID:  record.RemedyCodeID,
+	})
+}
@@ -0,0 +1,369 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxHolidayImportSize bounds uploaded .ics files.
+const maxHolidayImportSize = 1 << 20
+
+type HolidayCalendarController interface {
+	CreateHolidayCalendar(c *gin.Context)
+	GetHolidayCalendars(c *gin.Context)
+	GetHolidayCalendarByID(c *gin.Context)
+	UpdateHolidayCalendar(c *gin.Context)
+	DeleteHolidayCalendar(c *gin.Context)
+	GetHolidays(c *gin.Context)
+	CreateHoliday(c *gin.Context)
+	DeleteHoliday(c *gin.Context)
+	ImportHolidays(c *gin.Context)
+}
+
+type holidayCalendarController struct {
+	service services.HolidayCalendarService
+}
+
+func NewHolidayCalendarController(service services.HolidayCalendarService) HolidayCalendarController {
+	return &holidayCalendarController{service: service}
+}
+
+func toHolidayCalendarDTO(calendar models.HolidayCalendar) dto.HolidayCalendarDTO {
+	return dto.HolidayCalendarDTO{
+		ID:          calendar.ID,
+		Name:        calendar.Name,
+		WorkingDays: strings.Split(calendar.WorkingDays, ","),
+		CreatedAt:   calendar.CreatedAt,
+		UpdatedAt:   calendar.UpdatedAt,
+	}
+}
+
+func toHolidayDTO(holiday models.Holiday) dto.HolidayDTO {
+	return dto.HolidayDTO{
+		ID:   holiday.ID,
+		Date: holiday.Date,
+		Name: holiday.Name,
+	}
+}
+
+// CreateHolidayCalendar godoc
+// @Summary Create a holiday calendar
+// @Description Create a calendar of working days and holidays that schedules can follow. Working days default to Monday to Friday.
+// @Tags HolidayCalendars
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateHolidayCalendarRequest true "Create Holiday Calendar Request"
+// @Success 201 {object} dto.CreateHolidayCalendarResponse
+// @Router /v1/holiday-calendars [post]
+func (ctrl *holidayCalendarController) CreateHolidayCalendar(c *gin.Context) {
+	var req dto.CreateHolidayCalendarRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	calendar := &models.HolidayCalendar{
+		Name:        req.Name,
+		WorkingDays: strings.Join(req.WorkingDays, ","),
+	}
+
+	if err := ctrl.service.CreateHolidayCalendar(calendar); err != nil {
+		if err.Error() == "holiday calendar name already exists" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "invalid working days" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create holiday calendar: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateHolidayCalendarResponse{
+		Message:         "Holiday calendar created successfully",
+		HolidayCalendar: toHolidayCalendarDTO(*calendar),
+	})
+}
+
+// GetHolidayCalendars godoc
+// @Summary Get holiday calendars
+// @Description Retrieve all holiday calendars
+// @Tags HolidayCalendars
+// @Produce json
+// @Success 200 {object} dto.GetHolidayCalendarsResponse
+// @Router /v1/holiday-calendars [get]
+func (ctrl *holidayCalendarController) GetHolidayCalendars(c *gin.Context) {
+	calendars, err := ctrl.service.GetHolidayCalendars()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve holiday calendars: " + err.Error()})
+		return
+	}
+
+	calendarDTOs := make([]dto.HolidayCalendarDTO, len(calendars))
+	for i, calendar := range calendars {
+		calendarDTOs[i] = toHolidayCalendarDTO(calendar)
+	}
+
+	c.JSON(http.StatusOK, dto.GetHolidayCalendarsResponse{
+		Message:          "Holiday calendars retrieved successfully",
+		HolidayCalendars: calendarDTOs,
+	})
+}
+
+// GetHolidayCalendarByID godoc
+// @Summary Get a holiday calendar
+// @Description Retrieve a holiday calendar by its ID
+// @Tags HolidayCalendars
+// @Produce json
+// @Param id path string true "Holiday Calendar ID"
+// @Success 200 {object} dto.GetHolidayCalendarByIDResponse
+// @Router /v1/holiday-calendars/{id} [get]
+func (ctrl *holidayCalendarController) GetHolidayCalendarByID(c *gin.Context) {
+	calendar, err := ctrl.service.GetHolidayCalendarByID(c.Param("id"))
+	if err != nil {
+		if err.Error() == "holiday calendar not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Holiday calendar not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve holiday calendar: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetHolidayCalendarByIDResponse{
+		Message:         "Holiday calendar retrieved successfully",
+		HolidayCalendar: toHolidayCalendarDTO(*calendar),
+	})
+}
+
+// UpdateHolidayCalendar godoc
+// @Summary Update a holiday calendar
+// @Description Rename a holiday calendar or change its working days
+// @Tags HolidayCalendars
+// @Accept json
+// @Produce json
+// @Param id path string true "Holiday Calendar ID"
+// @Param request body dto.UpdateHolidayCalendarRequest true "Update Holiday Calendar Request"
+// @Success 200 {object} dto.UpdateHolidayCalendarResponse
+// @Router /v1/holiday-calendars/{id} [put]
+func (ctrl *holidayCalendarController) UpdateHolidayCalendar(c *gin.Context) {
+	var req dto.UpdateHolidayCalendarRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	calendar := &models.HolidayCalendar{
+		ID:          c.Param("id"),
+		Name:        req.Name,
+		WorkingDays: strings.Join(req.WorkingDays, ","),
+	}
+
+	if err := ctrl.service.UpdateHolidayCalendar(calendar); err != nil {
+		if err.Error() == "holiday calendar not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Holiday calendar not found"})
+			return
+		}
+		if err.Error() == "holiday calendar name already exists" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "invalid working days" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update holiday calendar: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UpdateHolidayCalendarResponse{
+		Message:         "Holiday calendar updated successfully",
+		HolidayCalendar: toHolidayCalendarDTO(*calendar),
+	})
+}
+
+// DeleteHolidayCalendar godoc
+// @Summary Delete a holiday calendar
+// @Description Delete a holiday calendar and its holidays. Calendars still used by schedules cannot be deleted.
+// @Tags HolidayCalendars
+// @Produce json
+// @Param id path string true "Holiday Calendar ID"
+// @Success 200 {object} dto.DeleteHolidayCalendarResponse
+// @Router /v1/holiday-calendars/{id} [delete]
+func (ctrl *holidayCalendarController) DeleteHolidayCalendar(c *gin.Context) {
+	if err := ctrl.service.DeleteHolidayCalendar(c.Param("id")); err != nil {
+		if err.Error() == "holiday calendar not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Holiday calendar not found"})
+			return
+		}
+		if err.Error() == "holiday calendar is in use" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete holiday calendar: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeleteHolidayCalendarResponse{
+		Message: "Holiday calendar deleted successfully",
+	})
+}
+
+// GetHolidays godoc
+// @Summary Get the holidays of a calendar
+// @Description List the holidays of a calendar, optionally within a date range
+// @Tags HolidayCalendars
+// @Produce json
+// @Param id path string true "Holiday Calendar ID"
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date, inclusive (YYYY-MM-DD)"
+// @Success 200 {object} dto.GetHolidaysResponse
+// @Router /v1/holiday-calendars/{id}/holidays [get]
+func (ctrl *holidayCalendarController) GetHolidays(c *gin.Context) {
+	var req dto.GetHolidaysRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	holidays, err := ctrl.service.GetHolidays(c.Param("id"), req.From, req.To)
+	if err != nil {
+		if err.Error() == "holiday calendar not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Holiday calendar not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve holidays: " + err.Error()})
+		return
+	}
+
+	holidayDTOs := make([]dto.HolidayDTO, len(holidays))
+	for i, holiday := range holidays {
+		holidayDTOs[i] = toHolidayDTO(holiday)
+	}
+
+	c.JSON(http.StatusOK, dto.GetHolidaysResponse{
+		Message:  "Holidays retrieved successfully",
+		Holidays: holidayDTOs,
+	})
+}
+
+// CreateHoliday godoc
+// @Summary Add a holiday
+// @Description Add a holiday to a calendar. A holiday already on that date is renamed.
+// @Tags HolidayCalendars
+// @Accept json
+// @Produce json
+// @Param id path string true "Holiday Calendar ID"
+// @Param request body dto.CreateHolidayRequest true "Create Holiday Request"
+// @Success 201 {object} dto.CreateHolidayResponse
+// @Router /v1/holiday-calendars/{id}/holidays [post]
+func (ctrl *holidayCalendarController) CreateHoliday(c *gin.Context) {
+	var req dto.CreateHolidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	date, _ := time.Parse("2006-01-02", req.Date)
+	holiday := &models.Holiday{
+		CalendarID: c.Param("id"),
+		Date:       date,
+		Name:       req.Name,
+	}
+
+	if err := ctrl.service.AddHoliday(holiday); err != nil {
+		if err.Error() == "holiday calendar not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Holiday calendar not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add holiday: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateHolidayResponse{
+		Message: "Holiday added successfully",
+		Holiday: toHolidayDTO(*holiday),
+	})
+}
+
+// DeleteHoliday godoc
+// @Summary Delete a holiday
+// @Description Remove a holiday from a calendar
+// @Tags HolidayCalendars
+// @Produce json
+// @Param id path string true "Holiday Calendar ID"
+// @Param holidayId path string true "Holiday ID"
+// @Success 200 {object} dto.DeleteHolidayResponse
+// @Router /v1/holiday-calendars/{id}/holidays/{holidayId} [delete]
+func (ctrl *holidayCalendarController) DeleteHoliday(c *gin.Context) {
+	if err := ctrl.service.DeleteHoliday(c.Param("id"), c.Param("holidayId")); err != nil {
+		if err.Error() == "holiday not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Holiday not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete holiday: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeleteHolidayResponse{
+		Message: "Holiday deleted successfully",
+	})
+}
+
+// ImportHolidays godoc
+// @Summary Import holidays from an iCalendar file
+// @Description Add every day covered by the events of an .ics file to the calendar. Send the file as the multipart field "file" or as a text/calendar request body.
+// @Tags HolidayCalendars
+// @Accept multipart/form-data
+// @Accept text/calendar
+// @Produce json
+// @Param id path string true "Holiday Calendar ID"
+// @Param file formData file false "iCalendar file"
+// @Success 200 {object} dto.ImportHolidaysResponse
+// @Router /v1/holiday-calendars/{id}/import [post]
+func (ctrl *holidayCalendarController) ImportHolidays(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxHolidayImportSize)
+
+	var body io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid upload: " + err.Error()})
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid upload: " + err.Error()})
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	imported, err := ctrl.service.ImportHolidays(c.Param("id"), body)
+	if err != nil {
+		if err.Error() == "holiday calendar not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Holiday calendar not found"})
+			return
+		}
+		if err.Error() == "invalid iCalendar file" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import holidays: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ImportHolidaysResponse{
+		Message:  "Holidays imported successfully",
+		Imported: imported,
+	})
+}
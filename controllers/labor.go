@@ -0,0 +1,304 @@
+package controllers
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LaborController interface {
+	StartTimer(c *gin.Context)
+	StopTimer(c *gin.Context)
+	LogLaborEntry(c *gin.Context)
+	GetLaborEntries(c *gin.Context)
+	DeleteLaborEntry(c *gin.Context)
+	GetLaborHoursReport(c *gin.Context)
+}
+
+type laborController struct {
+	service services.LaborService
+}
+
+func NewLaborController(service services.LaborService) LaborController {
+	return &laborController{service: service}
+}
+
+// toHours converts seconds to hours rounded to two decimals.
+func toHours(seconds int64) float64 {
+	return math.Round(float64(seconds)/36) / 100
+}
+
+// laborSeconds is the time logged by an entry; running timers count up to
+// now.
+func laborSeconds(entry models.LaborEntry, now time.Time) int64 {
+	if entry.EndedAt == nil {
+		return int64(now.Sub(entry.StartedAt).Seconds())
+	}
+	return entry.DurationSeconds
+}
+
+func toLaborEntryDTO(entry models.LaborEntry, now time.Time) dto.LaborEntryDTO {
+	return dto.LaborEntryDTO{
+		ID:        entry.ID,
+		RecordID:  entry.RecordID,
+		UserID:    entry.UserID,
+		UserName:  entry.User.Name,
+		StartedAt: entry.StartedAt,
+		EndedAt:   entry.EndedAt,
+		Running:   entry.EndedAt == nil,
+		Hours:     toHours(laborSeconds(entry, now)),
+		Manual:    entry.Manual,
+		Note:      entry.Note,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+// StartTimer godoc
+// @Summary Start a labor timer
+// @Description Start the authenticated user's timer on a maintenance record in progress. Several technicians may time the same record, but each user runs one timer at a time. Timers stop automatically when the record leaves in_progress.
+// @Tags Labor
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param request body dto.StartLaborTimerRequest false "Start Labor Timer Request"
+// @Success 201 {object} dto.LaborTimerResponse
+// @Router /v1/maintenance-records/{id}/labor/start [post]
+func (ctrl *laborController) StartTimer(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.StartLaborTimerRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+			return
+		}
+	}
+
+	now := time.Now()
+	entry, err := ctrl.service.StartTimer(c.Param("id"), userID, req.Note, now)
+	if err != nil {
+		switch err.Error() {
+		case "maintenance record not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is not in progress", "a timer is already running":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start timer: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.LaborTimerResponse{
+		Message:    "Timer started successfully",
+		LaborEntry: toLaborEntryDTO(*entry, now),
+	})
+}
+
+// StopTimer godoc
+// @Summary Stop a labor timer
+// @Description Stop the authenticated user's running timer on a maintenance record
+// @Tags Labor
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Success 200 {object} dto.LaborTimerResponse
+// @Router /v1/maintenance-records/{id}/labor/stop [post]
+func (ctrl *laborController) StopTimer(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	entry, err := ctrl.service.StopTimer(c.Param("id"), userID, now)
+	if err != nil {
+		switch err.Error() {
+		case "maintenance record not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "no timer is running on this maintenance record":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to stop timer: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.LaborTimerResponse{
+		Message:    "Timer stopped successfully",
+		LaborEntry: toLaborEntryDTO(*entry, now),
+	})
+}
+
+// LogLaborEntry godoc
+// @Summary Log labor time
+// @Description Log time spent on a maintenance record without a timer. Admins may log time for another user through user_id.
+// @Tags Labor
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param request body dto.LogLaborEntryRequest true "Log Labor Entry Request"
+// @Success 201 {object} dto.LogLaborEntryResponse
+// @Router /v1/maintenance-records/{id}/labor [post]
+func (ctrl *laborController) LogLaborEntry(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.LogLaborEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	entry := &models.LaborEntry{
+		RecordID:        c.Param("id"),
+		UserID:          req.UserID,
+		StartedAt:       req.StartedAt,
+		DurationSeconds: int64(req.DurationMinutes) * 60,
+		Note:            req.Note,
+	}
+
+	now := time.Now()
+	if err := ctrl.service.LogLaborEntry(entry, userID, role, now); err != nil {
+		switch err.Error() {
+		case "maintenance record not found", "user not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "access to labor entry denied":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case "labor entry cannot end in the future":
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to log labor entry: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.LogLaborEntryResponse{
+		Message:    "Labor entry logged successfully",
+		LaborEntry: toLaborEntryDTO(*entry, now),
+	})
+}
+
+// GetLaborEntries godoc
+// @Summary Get labor entries
+// @Description List the labor entries of a maintenance record with its total hours. Running timers count up to now.
+// @Tags Labor
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Success 200 {object} dto.GetLaborEntriesResponse
+// @Router /v1/maintenance-records/{id}/labor [get]
+func (ctrl *laborController) GetLaborEntries(c *gin.Context) {
+	entries, err := ctrl.service.GetLaborEntries(c.Param("id"))
+	if err != nil {
+		if err.Error() == "maintenance record not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve labor entries: " + err.Error()})
+		return
+	}
+
+	now := time.Now()
+	var totalSeconds int64
+	entryDTOs := make([]dto.LaborEntryDTO, len(entries))
+	for i, entry := range entries {
+		totalSeconds += laborSeconds(entry, now)
+		entryDTOs[i] = toLaborEntryDTO(entry, now)
+	}
+
+	c.JSON(http.StatusOK, dto.GetLaborEntriesResponse{
+		Message:      "Labor entries retrieved successfully",
+		LaborEntries: entryDTOs,
+		TotalHours:   toHours(totalSeconds),
+	})
+}
+
+// DeleteLaborEntry godoc
+// @Summary Delete a labor entry
+// @Description Delete a labor entry from a maintenance record. Technicians may only delete their own entries.
+// @Tags Labor
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param entryId path string true "Labor Entry ID"
+// @Success 200 {object} dto.DeleteLaborEntryResponse
+// @Router /v1/maintenance-records/{id}/labor/{entryId} [delete]
+func (ctrl *laborController) DeleteLaborEntry(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := ctrl.service.DeleteLaborEntry(c.Param("id"), c.Param("entryId"), userID, role); err != nil {
+		switch err.Error() {
+		case "labor entry not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "access to labor entry denied":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete labor entry: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeleteLaborEntryResponse{
+		Message: "Labor entry deleted successfully",
+	})
+}
+
+// GetLaborHoursReport godoc
+// @Summary Get a labor hours report
+// @Description Total the labor hours started between two dates, both inclusive, per maintenance record, asset or technician. Running timers are left out until they are stopped.
+// @Tags Reports
+// @Produce json
+// @Param group_by query string true "record, asset or technician"
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string true "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.GetLaborHoursReportResponse
+// @Router /v1/reports/labor-hours [get]
+func (ctrl *laborController) GetLaborHoursReport(c *gin.Context) {
+	var req dto.GetLaborHoursReportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	totals, err := ctrl.service.GetLaborTotals(req.GroupBy, req.From, req.To.AddDate(0, 0, 1))
+	if err != nil {
+		if err.Error() == "report start must be before its end" || err.Error() == "report range is too long" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve labor hours: " + err.Error()})
+		return
+	}
+
+	var totalSeconds int64
+	totalDTOs := make([]dto.LaborHoursDTO, len(totals))
+	for i, total := range totals {
+		totalSeconds += total.TotalSeconds
+		totalDTOs[i] = dto.LaborHoursDTO{
+			ID:         total.ID,
+			Name:       total.Name,
+			Hours:      toHours(total.TotalSeconds),
+			EntryCount: total.EntryCount,
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.GetLaborHoursReportResponse{
+		Message:    "Labor hours retrieved successfully",
+		GroupBy:    req.GroupBy,
+		From:       req.From,
+		To:         req.To,
+		Totals:     totalDTOs,
+		TotalHours: toHours(totalSeconds),
+	})
+}
@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"jaga/dto"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MaintenanceCalendarController interface {
+	GetMaintenanceCalendar(c *gin.Context)
+}
+
+type maintenanceCalendarController struct {
+	service services.MaintenanceCalendarService
+}
+
+func NewMaintenanceCalendarController(service services.MaintenanceCalendarService) MaintenanceCalendarController {
+	return &maintenanceCalendarController{service: service}
+}
+
+// GetMaintenanceCalendar godoc
+// @Summary Get the maintenance calendar
+// @Description Expand schedules into occurrences within a date range and merge them with existing maintenance records. Each entry is planned, generated, done, missed or paused.
+// @Tags MaintenanceCalendar
+// @Produce json
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string true "End date, inclusive (YYYY-MM-DD)"
+// @Param assigned_to query string false "Technician user ID"
+// @Param asset_id query string false "Asset ID"
+// @Success 200 {object} dto.GetMaintenanceCalendarResponse
+// @Router /v1/maintenance-calendar [get]
+func (ctrl *maintenanceCalendarController) GetMaintenanceCalendar(c *gin.Context) {
+	var req dto.GetMaintenanceCalendarRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	// The end date is inclusive.
+	to := req.To.Add(24*time.Hour - time.Nanosecond)
+
+	entries, err := ctrl.service.GetMaintenanceCalendar(req.From, to, req.AssetID, req.AssignedTo, time.Now())
+	if err != nil {
+		if err.Error() == "calendar start must be before its end" ||
+			err.Error() == "calendar window cannot exceed 366 days" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build maintenance calendar: " + err.Error()})
+		return
+	}
+
+	entryDTOs := make([]dto.MaintenanceCalendarEntryDTO, len(entries))
+	for i, entry := range entries {
+		entryDTOs[i] = dto.MaintenanceCalendarEntryDTO{
+			Date:        entry.Date,
+			ShiftedFrom: entry.ShiftedFrom,
+			State:       entry.State,
+			AssetID:     entry.AssetID,
+			AssetName:   entry.AssetName,
+			AssignedTo:  entry.AssignedTo,
+		}
+		if entry.Schedule != nil {
+			entryDTOs[i].ScheduleID = &entry.Schedule.ID
+			entryDTOs[i].ScheduleType = &entry.Schedule.ScheduleType
+		}
+		if entry.Record != nil {
+			entryDTOs[i].RecordID = &entry.Record.ID
+			entryDTOs[i].RecordStatus = &entry.Record.Status
+			entryDTOs[i].Description = &entry.Record.Description
+			entryDTOs[i].ScheduleID = entry.Record.ScheduleID
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.GetMaintenanceCalendarResponse{
+		Message: "Maintenance calendar retrieved successfully",
+		From:    req.From,
+		To:      to,
+		Entries: entryDTOs,
+	})
+}
@@ -15,8 +15,10 @@ type MaintenanceRecordController interface {
 	CreateMaintenanceRecord(c *gin.Context)
 	GetMaintenanceRecordByID(c *gin.Context)
 	GetMaintenanceRecords(c *gin.Context)
+	GetMyMaintenanceRecords(c *gin.Context)
 	UpdateMaintenanceRecord(c *gin.Context)
 	UpdateMaintenanceRecordStatus(c *gin.Context)
+	GetMaintenanceRecordHistory(c *gin.Context)
 	DeleteMaintenanceRecord(c *gin.Context)
 }
 
@@ -44,6 +46,12 @@ func (ctrl *maintenanceRecordController) CreateMaintenanceRecord(c *gin.Context)
 		return
 	}
 
+	createdBy, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
 	newRecord := &models.MaintenanceRecord{
 		AssetID:         req.AssetID,
 		ScheduleID:      req.ScheduleID,
@@ -51,9 +59,10 @@ func (ctrl *maintenanceRecordController) CreateMaintenanceRecord(c *gin.Context)
 		Description:     req.Description,
 		Status:          req.Status,
 		MaintenanceDate: req.MaintenanceDate,
+		Priority:        req.Priority,
 	}
 
-	if err := ctrl.service.CreateMaintenanceRecord(newRecord); err != nil {
+	if err := ctrl.service.CreateMaintenanceRecord(newRecord, createdBy.(string)); err != nil {
 		if err.Error() == "asset not found" ||
 			err.Error() == "maintenance schedule not found" ||
 			err.Error() == "performed by not found" {
@@ -101,6 +110,16 @@ func (ctrl *maintenanceRecordController) GetMaintenanceRecordByID(c *gin.Context
 			Description:     recordModel.Description,
 			Status:          recordModel.Status,
 			MaintenanceDate: recordModel.MaintenanceDate,
+			DueAt:           recordModel.DueAt,
+			ProblemCodeID:   recordModel.ProblemCodeID,
+			CauseCodeID:     recordModel.CauseCodeID,
+			RemedyCodeID:    recordModel.RemedyCodeID,
+			WorkRequestID:   recordModel.WorkRequestID,
+			Priority:        recordModel.Priority,
+			ResponseDueAt:   recordModel.ResponseDueAt,
+			ResolutionDueAt: recordModel.ResolutionDueAt,
+			RespondedAt:     recordModel.RespondedAt,
+			SLABreached:     recordModel.SLABreached,
 			CreatedAt:       recordModel.CreatedAt,
 			UpdatedAt:       recordModel.UpdatedAt,
 		},
@@ -110,13 +129,36 @@ func (ctrl *maintenanceRecordController) GetMaintenanceRecordByID(c *gin.Context
 
 // Get a list of maintenance records
 // @Summary Get a list of maintenance records
-// @Description Retrieve maintenance records with optional filters and pagination
+// @Description Retrieve maintenance records with optional filters and pagination. Besides its columns, the list can be sorted by priority, most urgent first when descending, and by sla_deadline, the record's next response or resolution deadline, with records without deadlines last.
 // @Tags MaintenanceRecords
 // @Accept json
 // @Produce json
+// @Param sort_by query string false "Column, priority or sla_deadline"
+// @Param sort_dir query string false "asc or desc"
 // @Success 200 {object} dto.GetMaintenanceRecordsResponse
 // @Router /v1/maintenance-records [get]
 func (ctrl *maintenanceRecordController) GetMaintenanceRecords(c *gin.Context) {
+	ctrl.listMaintenanceRecords(c, "")
+}
+
+// Get the maintenance records of the authenticated user
+// @Summary Get my maintenance records
+// @Description Retrieve the maintenance records performed by the authenticated user, with optional filters and pagination
+// @Tags MaintenanceRecords
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.GetMaintenanceRecordsResponse
+// @Router /v1/me/maintenance-records [get]
+func (ctrl *maintenanceRecordController) GetMyMaintenanceRecords(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	ctrl.listMaintenanceRecords(c, userID.(string))
+}
+
+func (ctrl *maintenanceRecordController) listMaintenanceRecords(c *gin.Context, performedBy string) {
 	var req dto.GetMaintenanceRecordsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
@@ -131,6 +173,7 @@ func (ctrl *maintenanceRecordController) GetMaintenanceRecords(c *gin.Context) {
 		req.AssetID,
 		req.ScheduleID,
 		req.Status,
+		performedBy,
 	)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve maintenance records: " + err.Error()})
@@ -148,6 +191,16 @@ func (ctrl *maintenanceRecordController) GetMaintenanceRecords(c *gin.Context) {
 			Description:     record.Description,
 			Status:          record.Status,
 			MaintenanceDate: record.MaintenanceDate,
+			DueAt:           record.DueAt,
+			ProblemCodeID:   record.ProblemCodeID,
+			CauseCodeID:     record.CauseCodeID,
+			RemedyCodeID:    record.RemedyCodeID,
+			WorkRequestID:   record.WorkRequestID,
+			Priority:        record.Priority,
+			ResponseDueAt:   record.ResponseDueAt,
+			ResolutionDueAt: record.ResolutionDueAt,
+			RespondedAt:     record.RespondedAt,
+			SLABreached:     record.SLABreached,
 			CreatedAt:       record.CreatedAt,
 			UpdatedAt:       record.UpdatedAt,
 		}
@@ -170,7 +223,7 @@ func (ctrl *maintenanceRecordController) GetMaintenanceRecords(c *gin.Context) {
 
 // Update an existing maintenance record
 // @Summary Update an existing maintenance record
-// @Description Update the details of an existing maintenance record
+// @Description Update the details of an existing maintenance record. Fields left out of the body keep their current values; an empty schedule_id or performed_by clears that link.
 // @Tags MaintenanceRecords
 // @Accept json
 // @Produce json
@@ -186,6 +239,12 @@ func (ctrl *maintenanceRecordController) UpdateMaintenanceRecord(c *gin.Context)
 		return
 	}
 
+	changedBy, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
 	updatedRecord := &models.MaintenanceRecord{
 		ID:              recordID,
 		AssetID:         req.AssetID,
@@ -194,9 +253,10 @@ func (ctrl *maintenanceRecordController) UpdateMaintenanceRecord(c *gin.Context)
 		Description:     req.Description,
 		Status:          req.Status,
 		MaintenanceDate: req.MaintenanceDate,
+		Priority:        req.Priority,
 	}
 
-	if err := ctrl.service.UpdateMaintenanceRecord(updatedRecord); err != nil {
+	if err := ctrl.service.UpdateMaintenanceRecord(updatedRecord, changedBy.(string)); err != nil {
 		if err.Error() == "maintenance record not found" ||
 			err.Error() == "asset not found" ||
 			err.Error() == "maintenance schedule not found" ||
@@ -204,6 +264,13 @@ func (ctrl *maintenanceRecordController) UpdateMaintenanceRecord(c *gin.Context)
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
 		}
+		if err.Error() == "invalid status transition" ||
+			err.Error() == "maintenance record is awaiting approval" ||
+			err.Error() == "required checklist items are incomplete" ||
+			err.Error() == "insufficient stock" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update maintenance record: " + err.Error()})
 		return
 	}
@@ -215,7 +282,7 @@ func (ctrl *maintenanceRecordController) UpdateMaintenanceRecord(c *gin.Context)
 
 // Update the status of a maintenance record
 // @Summary Update the status of a maintenance record
-// @Description Update the status of a specific maintenance record
+// @Description Update the status of a specific maintenance record. Changes not allowed by the record status state machine are rejected with 409 Conflict. Technicians may only update records assigned to them; updating an unassigned record claims it. Finishing a record takes its parts out of stock and fails with 409 Conflict when a location does not hold enough; cancelling it puts them back and skips the schedule occurrence it was generated for. Admins may cancel a finished record to reverse it, which also puts its parts back. Running labor timers stop when the record leaves in_progress. When the asset category's approval rule asks for sign-off, a technician finishing the record puts it in awaiting_approval instead; a record awaiting approval is finished only by approving it. The first move to in_progress is the record's SLA response and finishing it its resolution; either one after its deadline flags the record as breached.
 // @Tags MaintenanceRecords
 // @Accept json
 // @Produce json
@@ -231,11 +298,34 @@ func (ctrl *maintenanceRecordController) UpdateMaintenanceRecordStatus(c *gin.Co
 		return
 	}
 
-	if err := ctrl.service.UpdateMaintenanceRecordStatus(recordID, req.Status); err != nil {
+	changedBy, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	role, exists := c.Get("role")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Role not found in context"})
+		return
+	}
+
+	if err := ctrl.service.UpdateMaintenanceRecordStatus(recordID, req.Status, changedBy.(string), role.(string), req.Comment); err != nil {
 		if err.Error() == "maintenance record not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
 		}
+		if err.Error() == "maintenance record is assigned to another technician" ||
+			err.Error() == "only admins can reverse a finished maintenance record" {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "invalid status transition" ||
+			err.Error() == "maintenance record is awaiting approval" ||
+			err.Error() == "required checklist items are incomplete" ||
+			err.Error() == "insufficient stock" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update maintenance record status: " + err.Error()})
 		return
 	}
@@ -245,6 +335,50 @@ func (ctrl *maintenanceRecordController) UpdateMaintenanceRecordStatus(c *gin.Co
 	})
 }
 
+// Get the status history of a maintenance record
+// @Summary Get the status history of a maintenance record
+// @Description Retrieve every status change of a maintenance record, oldest first, with who made it and the optional comment. The first entry records the status the record was created in and has an empty from_status; records generated by the scheduler or a meter reading have no actor
+// @Tags MaintenanceRecords
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Success 200 {object} dto.GetMaintenanceRecordHistoryResponse
+// @Router /v1/maintenance-records/{id}/history [get]
+func (ctrl *maintenanceRecordController) GetMaintenanceRecordHistory(c *gin.Context) {
+	recordID := c.Param("id")
+	changes, err := ctrl.service.GetMaintenanceRecordHistory(recordID)
+	if err != nil {
+		if err.Error() == "maintenance record not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Maintenance record not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve maintenance record history: " + err.Error()})
+		return
+	}
+
+	history := make([]dto.MaintenanceRecordStatusChangeDTO, len(changes))
+	for i, change := range changes {
+		var changedByName *string
+		if change.Actor != nil {
+			changedByName = &change.Actor.Name
+		}
+		history[i] = dto.MaintenanceRecordStatusChangeDTO{
+			ID:            change.ID,
+			FromStatus:    change.FromStatus,
+			ToStatus:      change.ToStatus,
+			Comment:       change.Note,
+			ChangedBy:     change.ChangedBy,
+			ChangedByName: changedByName,
+			ChangedAt:     change.ChangedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.GetMaintenanceRecordHistoryResponse{
+		Message: "Maintenance record history retrieved successfully",
+		History: history,
+	})
+}
+
 // Delete a maintenance record by ID
 // @Summary Delete a maintenance record by ID
 // @Description Delete the maintenance record identified by the ID
@@ -3,6 +3,7 @@ package controllers
 import (
 	"math"
 	"net/http"
+	"time"
 
 	"jaga/dto"
 	"jaga/models"
@@ -15,7 +16,13 @@ type MaintenanceScheduleController interface {
 	CreateMaintenanceSchedule(c *gin.Context)
 	GetMaintenanceScheduleByID(c *gin.Context)
 	GetMaintenanceSchedules(c *gin.Context)
+	GetMyMaintenanceSchedules(c *gin.Context)
+	GetOverdueMaintenanceSchedules(c *gin.Context)
+	GetUpcomingOccurrences(c *gin.Context)
+	PreviewRecurrenceRule(c *gin.Context)
 	UpdateMaintenanceSchedule(c *gin.Context)
+	SuspendMaintenanceSchedule(c *gin.Context)
+	ResumeMaintenanceSchedule(c *gin.Context)
 	DeleteMaintenanceSchedule(c *gin.Context)
 }
 
@@ -47,7 +54,14 @@ func (ctrl *maintenanceScheduleController) CreateMaintenanceSchedule(c *gin.Cont
 		AssetID:             req.AssetID,
 		ScheduleType:        req.ScheduleType,
 		IntervalDays:        req.IntervalDays,
+		RecurrenceRule:      req.RecurrenceRule,
+		MeterID:             req.MeterID,
+		MeterInterval:       req.MeterInterval,
+		MeterThreshold:      req.MeterThreshold,
+		AdvanceFrom:         req.AdvanceFrom,
 		NextMaintenanceDate: req.NextMaintenanceDate,
+		HolidayCalendarID:   req.HolidayCalendarID,
+		ClosedDayPolicy:     req.ClosedDayPolicy,
 		ScheduledBy:         req.ScheduledBy,
 		AssignedTo:          req.AssignedTo,
 	}
@@ -57,6 +71,27 @@ func (ctrl *maintenanceScheduleController) CreateMaintenanceSchedule(c *gin.Cont
 			c.JSON(http.StatusNotFound, gin.H{"error": "Asset not found"})
 			return
 		}
+		if err.Error() == "meter not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Meter not found"})
+			return
+		}
+		if err.Error() == "assigned technician not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Assigned technician not found"})
+			return
+		}
+		if err.Error() == "holiday calendar not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Holiday calendar not found"})
+			return
+		}
+		if err.Error() == "meter conditions require a conditional schedule" ||
+			err.Error() == "meter threshold or interval is required" ||
+			err.Error() == "recurrence rules require a periodic schedule" ||
+			err.Error() == "interval days and recurrence rule cannot both be set" ||
+			err.Error() == "invalid recurrence rule" ||
+			err.Error() == "recurrence rule has no upcoming occurrences" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create maintenance schedule: " + err.Error()})
 		return
 	}
@@ -95,7 +130,20 @@ func (ctrl *maintenanceScheduleController) GetMaintenanceScheduleByID(c *gin.Con
 			AssetName:           scheduleModel.Asset.Name,
 			ScheduleType:        scheduleModel.ScheduleType,
 			IntervalDays:        scheduleModel.IntervalDays,
+			RecurrenceRule:      scheduleModel.RecurrenceRule,
+			MeterID:             scheduleModel.MeterID,
+			MeterInterval:       scheduleModel.MeterInterval,
+			MeterThreshold:      scheduleModel.MeterThreshold,
+			AdvanceFrom:         scheduleModel.AdvanceFrom,
 			NextMaintenanceDate: scheduleModel.NextMaintenanceDate,
+			HolidayCalendarID:   scheduleModel.HolidayCalendarID,
+			ClosedDayPolicy:     scheduleModel.ClosedDayPolicy,
+			Paused:              scheduleModel.SuspendedAt != nil,
+			SuspendedAt:         scheduleModel.SuspendedAt,
+			SuspendedUntil:      scheduleModel.SuspendedUntil,
+			SuspendedBy:         scheduleModel.SuspendedBy,
+			SuspendReason:       scheduleModel.SuspendReason,
+			ResumePolicy:        scheduleModel.ResumePolicy,
 			ScheduledBy:         scheduleModel.ScheduledBy,
 			AssignedTo:          scheduleModel.AssignedTo,
 			CreatedAt:           scheduleModel.CreatedAt,
@@ -113,6 +161,26 @@ func (ctrl *maintenanceScheduleController) GetMaintenanceScheduleByID(c *gin.Con
 // @Success 200 {object} dto.GetMaintenanceSchedulesResponse
 // @Router /v1/maintenance-schedules [get]
 func (ctrl *maintenanceScheduleController) GetMaintenanceSchedules(c *gin.Context) {
+	ctrl.listMaintenanceSchedules(c, "")
+}
+
+// GetMyMaintenanceSchedules godoc
+// @Summary Get my maintenance schedules
+// @Description Retrieve a paginated list of the maintenance schedules assigned to the authenticated user
+// @Tags MaintenanceSchedules
+// @Produce json
+// @Success 200 {object} dto.GetMaintenanceSchedulesResponse
+// @Router /v1/me/maintenance-schedules [get]
+func (ctrl *maintenanceScheduleController) GetMyMaintenanceSchedules(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	ctrl.listMaintenanceSchedules(c, userID.(string))
+}
+
+func (ctrl *maintenanceScheduleController) listMaintenanceSchedules(c *gin.Context, assignedTo string) {
 	var req dto.GetMaintenanceSchedulesRequest
 
 	if err := c.ShouldBindQuery(&req); err != nil {
@@ -127,6 +195,7 @@ func (ctrl *maintenanceScheduleController) GetMaintenanceSchedules(c *gin.Contex
 		req.SortDir,
 		req.AssetID,
 		req.ScheduleType,
+		assignedTo,
 		req.StartDate,
 		req.EndDate,
 	)
@@ -143,7 +212,20 @@ func (ctrl *maintenanceScheduleController) GetMaintenanceSchedules(c *gin.Contex
 			AssetName:           schedule.Asset.Name,
 			ScheduleType:        schedule.ScheduleType,
 			IntervalDays:        schedule.IntervalDays,
+			RecurrenceRule:      schedule.RecurrenceRule,
+			MeterID:             schedule.MeterID,
+			MeterInterval:       schedule.MeterInterval,
+			MeterThreshold:      schedule.MeterThreshold,
+			AdvanceFrom:         schedule.AdvanceFrom,
 			NextMaintenanceDate: schedule.NextMaintenanceDate,
+			HolidayCalendarID:   schedule.HolidayCalendarID,
+			ClosedDayPolicy:     schedule.ClosedDayPolicy,
+			Paused:              schedule.SuspendedAt != nil,
+			SuspendedAt:         schedule.SuspendedAt,
+			SuspendedUntil:      schedule.SuspendedUntil,
+			SuspendedBy:         schedule.SuspendedBy,
+			SuspendReason:       schedule.SuspendReason,
+			ResumePolicy:        schedule.ResumePolicy,
 			ScheduledBy:         schedule.ScheduledBy,
 			AssignedTo:          schedule.AssignedTo,
 			CreatedAt:           schedule.CreatedAt,
@@ -168,6 +250,108 @@ func (ctrl *maintenanceScheduleController) GetMaintenanceSchedules(c *gin.Contex
 	})
 }
 
+// GetOverdueMaintenanceSchedules godoc
+// @Summary Get overdue maintenance schedules
+// @Description List schedules whose due date has passed without a finished record, oldest first
+// @Tags MaintenanceSchedules
+// @Produce json
+// @Success 200 {object} dto.GetOverdueMaintenanceSchedulesResponse
+// @Router /v1/maintenance-schedules/overdue [get]
+func (ctrl *maintenanceScheduleController) GetOverdueMaintenanceSchedules(c *gin.Context) {
+	overdue, err := ctrl.service.GetOverdueMaintenanceSchedules(time.Now())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve overdue maintenance schedules: " + err.Error()})
+		return
+	}
+
+	overdueDTOs := make([]dto.OverdueMaintenanceScheduleDTO, len(overdue))
+	for i, item := range overdue {
+		overdueDTOs[i] = dto.OverdueMaintenanceScheduleDTO{
+			ScheduleID:          item.Schedule.ID,
+			AssetID:             item.Schedule.AssetID,
+			AssetName:           item.Schedule.Asset.Name,
+			AssetStatus:         item.Schedule.Asset.Status,
+			ScheduleType:        item.Schedule.ScheduleType,
+			NextMaintenanceDate: item.Schedule.NextMaintenanceDate,
+			DueDate:             item.DueDate,
+			DaysOverdue:         item.DaysOverdue,
+			AssignedTo:          item.Schedule.AssignedTo,
+		}
+		if item.Technician != nil {
+			overdueDTOs[i].AssignedToName = &item.Technician.Name
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.GetOverdueMaintenanceSchedulesResponse{
+		Message:              "Overdue maintenance schedules retrieved successfully",
+		MaintenanceSchedules: overdueDTOs,
+		TotalItems:           len(overdueDTOs),
+	})
+}
+
+// GetUpcomingOccurrences godoc
+// @Summary Preview the next occurrences of a maintenance schedule
+// @Description List the next due dates of a schedule, computed from its recurrence rule or interval
+// @Tags MaintenanceSchedules
+// @Produce json
+// @Param id path string true "Maintenance Schedule ID"
+// @Param count query int false "Number of occurrences (1-100, default 5)"
+// @Success 200 {object} dto.GetUpcomingOccurrencesResponse
+// @Router /v1/maintenance-schedules/{id}/occurrences [get]
+func (ctrl *maintenanceScheduleController) GetUpcomingOccurrences(c *gin.Context) {
+	var req dto.GetUpcomingOccurrencesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	occurrences, err := ctrl.service.GetUpcomingOccurrences(c.Param("id"), req.Count)
+	if err != nil {
+		if err.Error() == "maintenance schedule not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Maintenance schedule not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to compute occurrences: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetUpcomingOccurrencesResponse{
+		Message:     "Occurrences retrieved successfully",
+		Occurrences: occurrences,
+	})
+}
+
+// PreviewRecurrenceRule godoc
+// @Summary Preview a recurrence rule
+// @Description List the first occurrences of an RFC 5545 RRULE before saving it on a schedule
+// @Tags MaintenanceSchedules
+// @Accept json
+// @Produce json
+// @Param request body dto.PreviewRecurrenceRuleRequest true "Preview Recurrence Rule Request"
+// @Success 200 {object} dto.GetUpcomingOccurrencesResponse
+// @Router /v1/maintenance-schedules/preview-occurrences [post]
+func (ctrl *maintenanceScheduleController) PreviewRecurrenceRule(c *gin.Context) {
+	var req dto.PreviewRecurrenceRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+	if req.Count == 0 {
+		req.Count = 5
+	}
+
+	occurrences, err := ctrl.service.PreviewRecurrenceRule(req.RecurrenceRule, req.Start, req.Count)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetUpcomingOccurrencesResponse{
+		Message:     "Occurrences retrieved successfully",
+		Occurrences: occurrences,
+	})
+}
+
 // UpdateMaintenanceSchedule godoc
 // @Summary Update a maintenance schedule
 // @Description Update an existing maintenance schedule by its ID
@@ -201,16 +385,33 @@ func (ctrl *maintenanceScheduleController) UpdateMaintenanceSchedule(c *gin.Cont
 		AssetID:             existingSchedule.AssetID,
 		ScheduleType:        req.ScheduleType,
 		IntervalDays:        req.IntervalDays,
+		RecurrenceRule:      req.RecurrenceRule,
+		MeterID:             req.MeterID,
+		MeterInterval:       req.MeterInterval,
+		MeterThreshold:      req.MeterThreshold,
+		AdvanceFrom:         req.AdvanceFrom,
 		NextMaintenanceDate: req.NextMaintenanceDate,
+		HolidayCalendarID:   req.HolidayCalendarID,
+		ClosedDayPolicy:     req.ClosedDayPolicy,
 		ScheduledBy:         req.ScheduledBy,
 		AssignedTo:          req.AssignedTo,
 	}
 
 	if err := ctrl.service.UpdateMaintenanceSchedule(updatedSchedule); err != nil {
-		if err.Error() == "maintenance schedule not found" {
+		if err.Error() == "maintenance schedule not found" || err.Error() == "meter not found" ||
+			err.Error() == "assigned technician not found" || err.Error() == "holiday calendar not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
 		}
+		if err.Error() == "meter conditions require a conditional schedule" ||
+			err.Error() == "meter threshold or interval is required" ||
+			err.Error() == "recurrence rules require a periodic schedule" ||
+			err.Error() == "interval days and recurrence rule cannot both be set" ||
+			err.Error() == "invalid recurrence rule" ||
+			err.Error() == "recurrence rule has no upcoming occurrences" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update maintenance schedule: " + err.Error()})
 		return
 	}
@@ -220,6 +421,97 @@ func (ctrl *maintenanceScheduleController) UpdateMaintenanceSchedule(c *gin.Cont
 	})
 }
 
+// SuspendMaintenanceSchedule godoc
+// @Summary Suspend a maintenance schedule
+// @Description Pause a schedule, e.g. while its asset is mothballed or off-site. Suspended schedules generate no records and are never overdue. A schedule with a suspended-until date resumes automatically using the chosen resume policy.
+// @Tags MaintenanceSchedules
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Schedule ID"
+// @Param request body dto.SuspendMaintenanceScheduleRequest true "Suspend Maintenance Schedule Request"
+// @Success 200 {object} dto.SuspendMaintenanceScheduleResponse
+// @Router /v1/maintenance-schedules/{id}/suspend [post]
+func (ctrl *maintenanceScheduleController) SuspendMaintenanceSchedule(c *gin.Context) {
+	var req dto.SuspendMaintenanceScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	suspendedBy, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	err := ctrl.service.SuspendMaintenanceSchedule(c.Param("id"), suspendedBy.(string), req.Reason, req.SuspendedUntil, req.ResumePolicy, time.Now())
+	if err != nil {
+		if err.Error() == "maintenance schedule not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Maintenance schedule not found"})
+			return
+		}
+		if err.Error() == "maintenance schedule is already suspended" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "suspended until must be in the future" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to suspend maintenance schedule: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuspendMaintenanceScheduleResponse{
+		Message: "Maintenance schedule suspended successfully",
+	})
+}
+
+// ResumeMaintenanceSchedule godoc
+// @Summary Resume a maintenance schedule
+// @Description Lift the suspension of a schedule. skip_missed keeps the cadence and drops occurrences missed while suspended, restart_from_resume starts a new cycle now, and keep leaves the next maintenance date unchanged. Defaults to the policy chosen when suspending.
+// @Tags MaintenanceSchedules
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Schedule ID"
+// @Param request body dto.ResumeMaintenanceScheduleRequest false "Resume Maintenance Schedule Request"
+// @Success 200 {object} dto.ResumeMaintenanceScheduleResponse
+// @Router /v1/maintenance-schedules/{id}/resume [post]
+func (ctrl *maintenanceScheduleController) ResumeMaintenanceSchedule(c *gin.Context) {
+	var req dto.ResumeMaintenanceScheduleRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+			return
+		}
+	}
+
+	scheduleID := c.Param("id")
+	if err := ctrl.service.ResumeMaintenanceSchedule(scheduleID, req.Policy, time.Now()); err != nil {
+		if err.Error() == "maintenance schedule not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Maintenance schedule not found"})
+			return
+		}
+		if err.Error() == "maintenance schedule is not suspended" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to resume maintenance schedule: " + err.Error()})
+		return
+	}
+
+	schedule, err := ctrl.service.GetMaintenanceScheduleByID(scheduleID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve maintenance schedule: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ResumeMaintenanceScheduleResponse{
+		Message:             "Maintenance schedule resumed successfully",
+		NextMaintenanceDate: schedule.NextMaintenanceDate,
+	})
+}
+
 // DeleteMaintenanceSchedule godoc
 // @Summary Delete a maintenance schedule
 // @Description Delete an existing maintenance schedule by its ID
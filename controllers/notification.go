@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"math"
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationController interface {
+	GetMyNotifications(c *gin.Context)
+	MarkNotificationRead(c *gin.Context)
+	MarkAllNotificationsRead(c *gin.Context)
+}
+
+type notificationController struct {
+	service services.NotificationService
+}
+
+func NewNotificationController(service services.NotificationService) NotificationController {
+	return &notificationController{service: service}
+}
+
+func toNotificationDTO(notification models.Notification) dto.NotificationDTO {
+	var actorName *string
+	if notification.Actor != nil {
+		actorName = &notification.Actor.Name
+	}
+	return dto.NotificationDTO{
+		ID:           notification.ID,
+		Type:         notification.Type,
+		Message:      notification.Message,
+		ResourceType: notification.ResourceType,
+		ResourceID:   notification.ResourceID,
+		ActorID:      notification.ActorID,
+		ActorName:    actorName,
+		Read:         notification.ReadAt != nil,
+		ReadAt:       notification.ReadAt,
+		CreatedAt:    notification.CreatedAt,
+	}
+}
+
+// GetMyNotifications godoc
+// @Summary Get my notifications
+// @Description Retrieve the authenticated user's notifications, newest first, with the number still unread
+// @Tags Notifications
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param items_per_page query int false "Items per page" default(20)
+// @Param unread_only query bool false "Only unread notifications"
+// @Success 200 {object} dto.GetNotificationsResponse
+// @Router /v1/me/notifications [get]
+func (ctrl *notificationController) GetMyNotifications(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.GetNotificationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	notifications, totalItems, unread, err := ctrl.service.GetNotifications(userID, req.UnreadOnly, req.Page, req.ItemsPerPage)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve notifications: " + err.Error()})
+		return
+	}
+
+	notificationDTOs := make([]dto.NotificationDTO, len(notifications))
+	for i, notification := range notifications {
+		notificationDTOs[i] = toNotificationDTO(notification)
+	}
+
+	totalPages := 0
+	if req.ItemsPerPage > 0 {
+		totalPages = int(math.Ceil(float64(totalItems) / float64(req.ItemsPerPage)))
+	}
+
+	c.JSON(http.StatusOK, dto.GetNotificationsResponse{
+		Message:       "Notifications retrieved successfully",
+		Notifications: notificationDTOs,
+		UnreadCount:   int(unread),
+		TotalItems:    int(totalItems),
+		Page:          req.Page,
+		ItemsPerPage:  req.ItemsPerPage,
+		TotalPages:    totalPages,
+	})
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark a notification as read
+// @Description Mark one of the authenticated user's notifications as read
+// @Tags Notifications
+// @Produce json
+// @Param id path string true "Notification ID"
+// @Success 200 {object} dto.MarkNotificationReadResponse
+// @Router /v1/me/notifications/{id}/read [post]
+func (ctrl *notificationController) MarkNotificationRead(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := ctrl.service.MarkNotificationRead(userID, c.Param("id")); err != nil {
+		if err.Error() == "notification not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Notification not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark notification as read: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MarkNotificationReadResponse{
+		Message: "Notification marked as read",
+	})
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Mark all notifications as read
+// @Description Mark every unread notification of the authenticated user as read
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} dto.MarkNotificationReadResponse
+// @Router /v1/me/notifications/read-all [post]
+func (ctrl *notificationController) MarkAllNotificationsRead(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := ctrl.service.MarkAllNotificationsRead(userID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark notifications as read: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MarkNotificationReadResponse{
+		Message: "All notifications marked as read",
+	})
+}
@@ -0,0 +1,460 @@
+package controllers
+
+import (
+	"math"
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PartController interface {
+	CreatePart(c *gin.Context)
+	GetParts(c *gin.Context)
+	GetPartByID(c *gin.Context)
+	UpdatePart(c *gin.Context)
+	DeletePart(c *gin.Context)
+	SetPartStock(c *gin.Context)
+	GetLowStockParts(c *gin.Context)
+	GetRecordParts(c *gin.Context)
+	AddRecordPart(c *gin.Context)
+	RemoveRecordPart(c *gin.Context)
+}
+
+type partController struct {
+	service services.PartService
+}
+
+func NewPartController(service services.PartService) PartController {
+	return &partController{service: service}
+}
+
+func toPartDTO(part models.Part) dto.PartDTO {
+	categoryIDs := make([]string, len(part.Categories))
+	for i, category := range part.Categories {
+		categoryIDs[i] = category.ID
+	}
+
+	var total float64
+	stocks := make([]dto.PartStockDTO, len(part.Stocks))
+	for i, stock := range part.Stocks {
+		total += stock.Quantity
+		stocks[i] = dto.PartStockDTO{
+			LocationID:   stock.LocationID,
+			LocationName: stock.Location.Name,
+			Quantity:     stock.Quantity,
+			UpdatedAt:    stock.UpdatedAt,
+		}
+	}
+
+	return dto.PartDTO{
+		ID:                part.ID,
+		SKU:               part.SKU,
+		Name:              part.Name,
+		Description:       part.Description,
+		Unit:              part.Unit,
+		LowStockThreshold: part.LowStockThreshold,
+		UnitCost:          part.UnitCost,
+		CategoryIDs:       categoryIDs,
+		Stocks:            stocks,
+		TotalQuantity:     total,
+		LowStock:          part.LowStockThreshold > 0 && total <= part.LowStockThreshold,
+		CreatedAt:         part.CreatedAt,
+		UpdatedAt:         part.UpdatedAt,
+	}
+}
+
+func toRecordPartDTO(recordPart models.RecordPart) dto.RecordPartDTO {
+	return dto.RecordPartDTO{
+		ID:           recordPart.ID,
+		PartID:       recordPart.PartID,
+		PartSKU:      recordPart.Part.SKU,
+		PartName:     recordPart.Part.Name,
+		Unit:         recordPart.Part.Unit,
+		LocationID:   recordPart.LocationID,
+		LocationName: recordPart.Location.Name,
+		Quantity:     recordPart.Quantity,
+		UnitCost:     recordPart.UnitCost,
+		AddedBy:      recordPart.AddedBy,
+		Deducted:     recordPart.DeductedAt != nil,
+		DeductedAt:   recordPart.DeductedAt,
+		CreatedAt:    recordPart.CreatedAt,
+	}
+}
+
+// CreatePart godoc
+// @Summary Create a part
+// @Description Create a spare part. Parts linked to asset categories are only offered for assets of those categories; parts without categories fit every asset.
+// @Tags Parts
+// @Accept json
+// @Produce json
+// @Param request body dto.CreatePartRequest true "Create Part Request"
+// @Success 201 {object} dto.CreatePartResponse
+// @Router /v1/parts [post]
+func (ctrl *partController) CreatePart(c *gin.Context) {
+	var req dto.CreatePartRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	part := &models.Part{
+		SKU:               req.SKU,
+		Name:              req.Name,
+		Description:       req.Description,
+		Unit:              req.Unit,
+		LowStockThreshold: req.LowStockThreshold,
+		UnitCost:          req.UnitCost,
+	}
+
+	if err := ctrl.service.CreatePart(part, req.CategoryIDs); err != nil {
+		if err.Error() == "asset category not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "part sku already exists" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create part: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreatePartResponse{
+		Message: "Part created successfully",
+		Part:    toPartDTO(*part),
+	})
+}
+
+// GetParts godoc
+// @Summary Get parts
+// @Description Retrieve parts with their stock, optionally searched by name or SKU and filtered by asset category. Technicians only see parts without categories and parts for the assets of their open maintenance records.
+// @Tags Parts
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param items_per_page query int false "Items per page" default(10)
+// @Param search query string false "Search by name or SKU"
+// @Param category_id query string false "Filter by asset category ID"
+// @Success 200 {object} dto.GetPartsResponse
+// @Router /v1/parts [get]
+func (ctrl *partController) GetParts(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.GetPartsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	parts, totalItems, err := ctrl.service.GetParts(req.Page, req.ItemsPerPage, req.Search, req.CategoryID, userID, role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve parts: " + err.Error()})
+		return
+	}
+
+	partDTOs := make([]dto.PartDTO, len(parts))
+	for i, part := range parts {
+		partDTOs[i] = toPartDTO(part)
+	}
+
+	totalPages := 0
+	if req.ItemsPerPage > 0 {
+		totalPages = int(math.Ceil(float64(totalItems) / float64(req.ItemsPerPage)))
+	}
+
+	c.JSON(http.StatusOK, dto.GetPartsResponse{
+		Message:      "Parts retrieved successfully",
+		Parts:        partDTOs,
+		TotalItems:   int(totalItems),
+		Page:         req.Page,
+		ItemsPerPage: req.ItemsPerPage,
+		TotalPages:   totalPages,
+	})
+}
+
+// GetPartByID godoc
+// @Summary Get a part
+// @Description Retrieve a part and its stock by its ID
+// @Tags Parts
+// @Produce json
+// @Param id path string true "Part ID"
+// @Success 200 {object} dto.GetPartByIDResponse
+// @Router /v1/parts/{id} [get]
+func (ctrl *partController) GetPartByID(c *gin.Context) {
+	part, err := ctrl.service.GetPartByID(c.Param("id"))
+	if err != nil {
+		if err.Error() == "part not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Part not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve part: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetPartByIDResponse{
+		Message: "Part retrieved successfully",
+		Part:    toPartDTO(*part),
+	})
+}
+
+// UpdatePart godoc
+// @Summary Update a part
+// @Description Update a part's details, low-stock threshold or asset categories. Omitted fields are left unchanged.
+// @Tags Parts
+// @Accept json
+// @Produce json
+// @Param id path string true "Part ID"
+// @Param request body dto.UpdatePartRequest true "Update Part Request"
+// @Success 200 {object} dto.UpdatePartResponse
+// @Router /v1/parts/{id} [put]
+func (ctrl *partController) UpdatePart(c *gin.Context) {
+	var req dto.UpdatePartRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	part := &models.Part{
+		ID:          c.Param("id"),
+		SKU:         req.SKU,
+		Name:        req.Name,
+		Description: req.Description,
+		Unit:        req.Unit,
+		UnitCost:    req.UnitCost,
+	}
+
+	if err := ctrl.service.UpdatePart(part, req.LowStockThreshold, req.CategoryIDs); err != nil {
+		if err.Error() == "part not found" || err.Error() == "asset category not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "part sku already exists" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update part: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UpdatePartResponse{
+		Message: "Part updated successfully",
+		Part:    toPartDTO(*part),
+	})
+}
+
+// DeletePart godoc
+// @Summary Delete a part
+// @Description Delete a part and its stock. Parts that were used on a maintenance record cannot be deleted.
+// @Tags Parts
+// @Produce json
+// @Param id path string true "Part ID"
+// @Success 200 {object} dto.DeletePartResponse
+// @Router /v1/parts/{id} [delete]
+func (ctrl *partController) DeletePart(c *gin.Context) {
+	if err := ctrl.service.DeletePart(c.Param("id")); err != nil {
+		if err.Error() == "part not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Part not found"})
+			return
+		}
+		if err.Error() == "part is in use" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete part: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeletePartResponse{
+		Message: "Part deleted successfully",
+	})
+}
+
+// SetPartStock godoc
+// @Summary Set part stock
+// @Description Set the quantity of a part on hand at a stock location, e.g. after a delivery or a stock count
+// @Tags Parts
+// @Accept json
+// @Produce json
+// @Param id path string true "Part ID"
+// @Param locationId path string true "Stock Location ID"
+// @Param request body dto.SetPartStockRequest true "Set Part Stock Request"
+// @Success 200 {object} dto.SetPartStockResponse
+// @Router /v1/parts/{id}/stock/{locationId} [put]
+func (ctrl *partController) SetPartStock(c *gin.Context) {
+	var req dto.SetPartStockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	part, err := ctrl.service.SetPartStock(c.Param("id"), c.Param("locationId"), *req.Quantity)
+	if err != nil {
+		if err.Error() == "part not found" || err.Error() == "stock location not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to set part stock: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SetPartStockResponse{
+		Message: "Part stock updated successfully",
+		Part:    toPartDTO(*part),
+	})
+}
+
+// GetLowStockParts godoc
+// @Summary Get low-stock alerts
+// @Description List the parts whose total stock over all locations is at or below their low-stock threshold
+// @Tags Parts
+// @Produce json
+// @Success 200 {object} dto.GetLowStockPartsResponse
+// @Router /v1/parts/low-stock [get]
+func (ctrl *partController) GetLowStockParts(c *gin.Context) {
+	parts, err := ctrl.service.GetLowStockParts()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve low-stock parts: " + err.Error()})
+		return
+	}
+
+	partDTOs := make([]dto.PartDTO, len(parts))
+	for i, part := range parts {
+		partDTOs[i] = toPartDTO(part)
+	}
+
+	c.JSON(http.StatusOK, dto.GetLowStockPartsResponse{
+		Message: "Low-stock parts retrieved successfully",
+		Parts:   partDTOs,
+	})
+}
+
+// GetRecordParts godoc
+// @Summary Get parts used on a maintenance record
+// @Description List the parts used on a maintenance record and whether they have been taken out of stock yet
+// @Tags Parts
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Success 200 {object} dto.GetRecordPartsResponse
+// @Router /v1/maintenance-records/{id}/parts [get]
+func (ctrl *partController) GetRecordParts(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	recordParts, err := ctrl.service.GetRecordParts(c.Param("id"), userID, role)
+	if err != nil {
+		if err.Error() == "maintenance record not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "maintenance record is assigned to another technician" {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve record parts: " + err.Error()})
+		return
+	}
+
+	recordPartDTOs := make([]dto.RecordPartDTO, len(recordParts))
+	for i, recordPart := range recordParts {
+		recordPartDTOs[i] = toRecordPartDTO(recordPart)
+	}
+
+	c.JSON(http.StatusOK, dto.GetRecordPartsResponse{
+		Message:     "Record parts retrieved successfully",
+		RecordParts: recordPartDTOs,
+	})
+}
+
+// AddRecordPart godoc
+// @Summary Add a part to a maintenance record
+// @Description Record a quantity of a part used on an open maintenance record. The quantity is taken out of the given stock location when the record finishes and put back if it is cancelled.
+// @Tags Parts
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param request body dto.AddRecordPartRequest true "Add Record Part Request"
+// @Success 201 {object} dto.AddRecordPartResponse
+// @Router /v1/maintenance-records/{id}/parts [post]
+func (ctrl *partController) AddRecordPart(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AddRecordPartRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	recordPart := &models.RecordPart{
+		RecordID:   c.Param("id"),
+		PartID:     req.PartID,
+		LocationID: req.LocationID,
+		Quantity:   req.Quantity,
+		AddedBy:    userID,
+	}
+
+	if err := ctrl.service.AddRecordPart(recordPart, role); err != nil {
+		switch err.Error() {
+		case "maintenance record not found", "part not found", "stock location not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is assigned to another technician":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case "part does not fit the asset category":
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case "maintenance record is closed":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add record part: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.AddRecordPartResponse{
+		Message:    "Record part added successfully",
+		RecordPart: toRecordPartDTO(*recordPart),
+	})
+}
+
+// RemoveRecordPart godoc
+// @Summary Remove a part from a maintenance record
+// @Description Remove a part usage from an open maintenance record before it is taken out of stock
+// @Tags Parts
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param partUsageId path string true "Record Part ID"
+// @Success 200 {object} dto.RemoveRecordPartResponse
+// @Router /v1/maintenance-records/{id}/parts/{partUsageId} [delete]
+func (ctrl *partController) RemoveRecordPart(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := ctrl.service.RemoveRecordPart(c.Param("id"), c.Param("partUsageId"), userID, role); err != nil {
+		switch err.Error() {
+		case "maintenance record not found", "record part not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is assigned to another technician":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case "maintenance record is closed":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove record part: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RemoveRecordPartResponse{
+		Message: "Record part removed successfully",
+	})
+}
@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecordCommentController interface {
+	GetRecordComments(c *gin.Context)
+	CreateRecordComment(c *gin.Context)
+	UpdateRecordComment(c *gin.Context)
+}
+
+type recordCommentController struct {
+	service services.RecordCommentService
+}
+
+func NewRecordCommentController(service services.RecordCommentService) RecordCommentController {
+	return &recordCommentController{service: service}
+}
+
+func toRecordCommentDTO(comment models.RecordComment) dto.RecordCommentDTO {
+	mentions := make([]dto.MentionDTO, len(comment.Mentions))
+	for i, mention := range comment.Mentions {
+		mentions[i] = dto.MentionDTO{
+			UserID:   mention.UserID,
+			UserName: mention.User.Name,
+			Email:    mention.User.Email,
+		}
+	}
+	return dto.RecordCommentDTO{
+		ID:         comment.ID,
+		RecordID:   comment.RecordID,
+		AuthorID:   comment.AuthorID,
+		AuthorName: comment.Author.Name,
+		Body:       comment.Body,
+		Mentions:   mentions,
+		Edited:     comment.EditedAt != nil,
+		EditedAt:   comment.EditedAt,
+		CreatedAt:  comment.CreatedAt,
+	}
+}
+
+// GetRecordComments godoc
+// @Summary Get maintenance record comments
+// @Description List the comment thread of a maintenance record, oldest first. Technicians only see the threads of records assigned to them or unassigned records.
+// @Tags Comments
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Success 200 {object} dto.GetRecordCommentsResponse
+// @Router /v1/maintenance-records/{id}/comments [get]
+func (ctrl *recordCommentController) GetRecordComments(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	comments, err := ctrl.service.GetRecordComments(c.Param("id"), userID, role)
+	if err != nil {
+		switch err.Error() {
+		case "maintenance record not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is assigned to another technician":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve comments: " + err.Error()})
+		}
+		return
+	}
+
+	commentDTOs := make([]dto.RecordCommentDTO, len(comments))
+	for i, comment := range comments {
+		commentDTOs[i] = toRecordCommentDTO(comment)
+	}
+
+	c.JSON(http.StatusOK, dto.GetRecordCommentsResponse{
+		Message:  "Comments retrieved successfully",
+		Comments: commentDTOs,
+	})
+}
+
+// CreateRecordComment godoc
+// @Summary Comment on a maintenance record
+// @Description Append a comment to a maintenance record's thread. Mention users with @ followed by their email address, e.g. @jane@example.com, to notify them. Technicians may comment on records assigned to them or unassigned records.
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param request body dto.CreateRecordCommentRequest true "Create Comment Request"
+// @Success 201 {object} dto.CreateRecordCommentResponse
+// @Router /v1/maintenance-records/{id}/comments [post]
+func (ctrl *recordCommentController) CreateRecordComment(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateRecordCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	comment := &models.RecordComment{
+		RecordID: c.Param("id"),
+		AuthorID: userID,
+		Body:     req.Body,
+	}
+
+	if err := ctrl.service.CreateRecordComment(comment, role); err != nil {
+		switch err.Error() {
+		case "maintenance record not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is assigned to another technician":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case "too many mentions in comment":
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create comment: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateRecordCommentResponse{
+		Message: "Comment created successfully",
+		Comment: toRecordCommentDTO(*comment),
+	})
+}
+
+// UpdateRecordComment godoc
+// @Summary Edit a maintenance record comment
+// @Description Change the text of a comment. Only its author may, within the configured grace window after posting. Users newly mentioned by the edit are notified.
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance Record ID"
+// @Param commentId path string true "Comment ID"
+// @Param request body dto.UpdateRecordCommentRequest true "Update Comment Request"
+// @Success 200 {object} dto.UpdateRecordCommentResponse
+// @Router /v1/maintenance-records/{id}/comments/{commentId} [put]
+func (ctrl *recordCommentController) UpdateRecordComment(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateRecordCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	comment := &models.RecordComment{
+		ID:       c.Param("commentId"),
+		RecordID: c.Param("id"),
+		Body:     req.Body,
+	}
+
+	if err := ctrl.service.UpdateRecordComment(comment, userID, role); err != nil {
+		switch err.Error() {
+		case "maintenance record not found", "comment not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "maintenance record is assigned to another technician", "only the author can edit a comment":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case "comment can no longer be edited":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case "too many mentions in comment":
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update comment: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UpdateRecordCommentResponse{
+		Message: "Comment updated successfully",
+		Comment: toRecordCommentDTO(*comment),
+	})
+}
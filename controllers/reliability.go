@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"jaga/dto"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReliabilityController interface {
+	GetReliabilityReport(c *gin.Context)
+}
+
+type reliabilityController struct {
+	service services.ReliabilityService
+}
+
+func NewReliabilityController(service services.ReliabilityService) ReliabilityController {
+	return &reliabilityController{service: service}
+}
+
+// optionalHours converts optional seconds to hours rounded to two decimals.
+func optionalHours(seconds *int64) *float64 {
+	if seconds == nil {
+		return nil
+	}
+	hours := toHours(*seconds)
+	return &hours
+}
+
+// GetReliabilityReport godoc
+// @Summary Get a reliability report
+// @Description Compute MTBF and MTTR per asset or asset category between two dates, both inclusive. Failures are the records with a problem code dated within the range, cancelled ones aside. A repair runs from the record's first move to in_progress until it finished. MTBF divides the time in service, less repairs, by the number of failures; both are null when there is nothing to average.
+// @Tags Reports
+// @Produce json
+// @Param group_by query string true "asset or category"
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string true "End date (YYYY-MM-DD)"
+// @Param category_id query string false "Only assets of this category"
+// @Success 200 {object} dto.GetReliabilityReportResponse
+// @Router /v1/reports/reliability [get]
+func (ctrl *reliabilityController) GetReliabilityReport(c *gin.Context) {
+	var req dto.GetReliabilityReportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	metrics, err := ctrl.service.GetReliability(req.GroupBy, req.From, req.To.AddDate(0, 0, 1), req.CategoryID, time.Now())
+	if err != nil {
+		switch err.Error() {
+		case "asset category not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": "Asset category not found"})
+		case "report start must be before its end", "report range is too long":
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve reliability: " + err.Error()})
+		}
+		return
+	}
+
+	metricDTOs := make([]dto.ReliabilityMetricsDTO, len(metrics))
+	for i, metric := range metrics {
+		metricDTOs[i] = dto.ReliabilityMetricsDTO{
+			ID:             metric.ID,
+			Name:           metric.Name,
+			AssetCount:     metric.AssetCount,
+			FailureCount:   metric.FailureCount,
+			RepairCount:    metric.RepairCount,
+			OperatingHours: toHours(metric.OperatingSeconds),
+			RepairHours:    toHours(metric.RepairSeconds),
+			MTBFHours:      optionalHours(metric.MTBFSeconds),
+			MTTRHours:      optionalHours(metric.MTTRSeconds),
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.GetReliabilityReportResponse{
+		Message: "Reliability retrieved successfully",
+		GroupBy: req.GroupBy,
+		From:    req.From,
+		To:      req.To,
+		Metrics: metricDTOs,
+	})
+}
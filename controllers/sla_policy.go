@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SLAPolicyController interface {
+	GetSLAPolicies(c *gin.Context)
+	SetSLAPolicy(c *gin.Context)
+	DeleteSLAPolicy(c *gin.Context)
+}
+
+type slaPolicyController struct {
+	service services.SLAPolicyService
+}
+
+func NewSLAPolicyController(service services.SLAPolicyService) SLAPolicyController {
+	return &slaPolicyController{service: service}
+}
+
+func toSLAPolicyDTO(policy *models.SLAPolicy) dto.SLAPolicyDTO {
+	return dto.SLAPolicyDTO{
+		Priority:          policy.Priority,
+		ResponseMinutes:   policy.ResponseMinutes,
+		ResolutionMinutes: policy.ResolutionMinutes,
+	}
+}
+
+// GetSLAPolicies godoc
+// @Summary Get the SLA policies
+// @Description List the response and resolution times of every priority that has a policy, most urgent first. Records of a priority without a policy have no deadlines.
+// @Tags SLAPolicies
+// @Produce json
+// @Success 200 {object} dto.GetSLAPoliciesResponse
+// @Router /v1/sla-policies [get]
+func (ctrl *slaPolicyController) GetSLAPolicies(c *gin.Context) {
+	policies, err := ctrl.service.GetSLAPolicies()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve SLA policies: " + err.Error()})
+		return
+	}
+
+	policyDTOs := make([]dto.SLAPolicyDTO, len(policies))
+	for i := range policies {
+		policyDTOs[i] = toSLAPolicyDTO(&policies[i])
+	}
+
+	c.JSON(http.StatusOK, dto.GetSLAPoliciesResponse{
+		Message:  "SLA policies retrieved successfully",
+		Policies: policyDTOs,
+	})
+}
+
+// SetSLAPolicy godoc
+// @Summary Set the SLA policy of a priority
+// @Description Set how many minutes records of a priority may take to be responded to, moved to in_progress, and resolved, finished. Both count from when the record is created, or from its maintenance date when that is later. New records, and records whose priority or maintenance date changes, get deadlines from the policy; existing deadlines are kept.
+// @Tags SLAPolicies
+// @Accept json
+// @Produce json
+// @Param priority path string true "low, medium, high or critical"
+// @Param request body dto.SetSLAPolicyRequest true "Set SLA Policy Request"
+// @Success 200 {object} dto.SLAPolicyResponse
+// @Router /v1/sla-policies/{priority} [put]
+func (ctrl *slaPolicyController) SetSLAPolicy(c *gin.Context) {
+	var req dto.SetSLAPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	policy := &models.SLAPolicy{
+		Priority:          c.Param("priority"),
+		ResponseMinutes:   req.ResponseMinutes,
+		ResolutionMinutes: req.ResolutionMinutes,
+	}
+	if err := ctrl.service.SetSLAPolicy(policy); err != nil {
+		if err.Error() == "invalid priority" ||
+			err.Error() == "resolution time cannot be shorter than response time" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update SLA policy: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SLAPolicyResponse{
+		Message: "SLA policy updated successfully",
+		Policy:  toSLAPolicyDTO(policy),
+	})
+}
+
+// DeleteSLAPolicy godoc
+// @Summary Delete the SLA policy of a priority
+// @Description Stop giving deadlines to new records of a priority. Existing deadlines are kept.
+// @Tags SLAPolicies
+// @Produce json
+// @Param priority path string true "low, medium, high or critical"
+// @Success 200 {object} dto.DeleteSLAPolicyResponse
+// @Router /v1/sla-policies/{priority} [delete]
+func (ctrl *slaPolicyController) DeleteSLAPolicy(c *gin.Context) {
+	if err := ctrl.service.DeleteSLAPolicy(c.Param("priority")); err != nil {
+		if err.Error() == "sla policy not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "SLA policy not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete SLA policy: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeleteSLAPolicyResponse{
+		Message: "SLA policy deleted successfully",
+	})
+}
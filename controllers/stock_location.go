@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StockLocationController interface {
+	CreateStockLocation(c *gin.Context)
+	GetStockLocations(c *gin.Context)
+	GetStockLocationByID(c *gin.Context)
+	UpdateStockLocation(c *gin.Context)
+	DeleteStockLocation(c *gin.Context)
+}
+
+type stockLocationController struct {
+	service services.StockLocationService
+}
+
+func NewStockLocationController(service services.StockLocationService) StockLocationController {
+	return &stockLocationController{service: service}
+}
+
+func toStockLocationDTO(location models.StockLocation) dto.StockLocationDTO {
+	return dto.StockLocationDTO{
+		ID:          location.ID,
+		Name:        location.Name,
+		Description: location.Description,
+		CreatedAt:   location.CreatedAt,
+		UpdatedAt:   location.UpdatedAt,
+	}
+}
+
+// CreateStockLocation godoc
+// @Summary Create a stock location
+// @Description Create a place where parts are kept, such as a warehouse or a service van
+// @Tags StockLocations
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateStockLocationRequest true "Create Stock Location Request"
+// @Success 201 {object} dto.CreateStockLocationResponse
+// @Router /v1/stock-locations [post]
+func (ctrl *stockLocationController) CreateStockLocation(c *gin.Context) {
+	var req dto.CreateStockLocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	location := &models.StockLocation{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	if err := ctrl.service.CreateStockLocation(location); err != nil {
+		if err.Error() == "stock location name already exists" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create stock location: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateStockLocationResponse{
+		Message:       "Stock location created successfully",
+		StockLocation: toStockLocationDTO(*location),
+	})
+}
+
+// GetStockLocations godoc
+// @Summary Get stock locations
+// @Description Retrieve all stock locations
+// @Tags StockLocations
+// @Produce json
+// @Success 200 {object} dto.GetStockLocationsResponse
+// @Router /v1/stock-locations [get]
+func (ctrl *stockLocationController) GetStockLocations(c *gin.Context) {
+	locations, err := ctrl.service.GetStockLocations()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve stock locations: " + err.Error()})
+		return
+	}
+
+	locationDTOs := make([]dto.StockLocationDTO, len(locations))
+	for i, location := range locations {
+		locationDTOs[i] = toStockLocationDTO(location)
+	}
+
+	c.JSON(http.StatusOK, dto.GetStockLocationsResponse{
+		Message:        "Stock locations retrieved successfully",
+		StockLocations: locationDTOs,
+	})
+}
+
+// GetStockLocationByID godoc
+// @Summary Get a stock location
+// @Description Retrieve a stock location by its ID
+// @Tags StockLocations
+// @Produce json
+// @Param id path string true "Stock Location ID"
+// @Success 200 {object} dto.GetStockLocationByIDResponse
+// @Router /v1/stock-locations/{id} [get]
+func (ctrl *stockLocationController) GetStockLocationByID(c *gin.Context) {
+	location, err := ctrl.service.GetStockLocationByID(c.Param("id"))
+	if err != nil {
+		if err.Error() == "stock location not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Stock location not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve stock location: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetStockLocationByIDResponse{
+		Message:       "Stock location retrieved successfully",
+		StockLocation: toStockLocationDTO(*location),
+	})
+}
+
+// UpdateStockLocation godoc
+// @Summary Update a stock location
+// @Description Rename a stock location or change its description
+// @Tags StockLocations
+// @Accept json
+// @Produce json
+// @Param id path string true "Stock Location ID"
+// @Param request body dto.UpdateStockLocationRequest true "Update Stock Location Request"
+// @Success 200 {object} dto.UpdateStockLocationResponse
+// @Router /v1/stock-locations/{id} [put]
+func (ctrl *stockLocationController) UpdateStockLocation(c *gin.Context) {
+	var req dto.UpdateStockLocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	location := &models.StockLocation{
+		ID:          c.Param("id"),
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	if err := ctrl.service.UpdateStockLocation(location); err != nil {
+		if err.Error() == "stock location not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Stock location not found"})
+			return
+		}
+		if err.Error() == "stock location name already exists" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update stock location: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UpdateStockLocationResponse{
+		Message:       "Stock location updated successfully",
+		StockLocation: toStockLocationDTO(*location),
+	})
+}
+
+// DeleteStockLocation godoc
+// @Summary Delete a stock location
+// @Description Delete a stock location that holds no stock and was never used on a maintenance record
+// @Tags StockLocations
+// @Produce json
+// @Param id path string true "Stock Location ID"
+// @Success 200 {object} dto.DeleteStockLocationResponse
+// @Router /v1/stock-locations/{id} [delete]
+func (ctrl *stockLocationController) DeleteStockLocation(c *gin.Context) {
+	if err := ctrl.service.DeleteStockLocation(c.Param("id")); err != nil {
+		if err.Error() == "stock location not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Stock location not found"})
+			return
+		}
+		if err.Error() == "stock location is in use" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete stock location: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeleteStockLocationResponse{
+		Message: "Stock location deleted successfully",
+	})
+}
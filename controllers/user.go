@@ -44,10 +44,12 @@ func (ctrl *UserController) GetUsers(c *gin.Context) {
 	userDTOs := make([]dto.UserDTO, len(usersModel))
 	for i, user := range usersModel {
 		userDTOs[i] = dto.UserDTO{
-			ID:    user.ID,
-			Name:  user.Name,
-			Email: user.Email,
-			Role:  user.Role,
+			ID:         user.ID,
+			Name:       user.Name,
+			Email:      user.Email,
+			Role:       user.Role,
+			HourlyRate: user.HourlyRate,
+			ManagerID:  user.ManagerID,
 		}
 	}
 
@@ -89,10 +91,12 @@ func (ctrl *UserController) GetUserByID(c *gin.Context) {
 	}
 
 	userDTO := dto.UserDTO{
-		ID:    userModel.ID,
-		Name:  userModel.Name,
-		Email: userModel.Email,
-		Role:  userModel.Role,
+		ID:         userModel.ID,
+		Name:       userModel.Name,
+		Email:      userModel.Email,
+		Role:       userModel.Role,
+		HourlyRate: userModel.HourlyRate,
+		ManagerID:  userModel.ManagerID,
 	}
 
 	c.JSON(http.StatusOK, dto.GetUserByIDResponse{
@@ -134,6 +138,8 @@ func (ctrl *UserController) CreateUser(c *gin.Context) {
 		Email:        req.Email,
 		PasswordHash: req.Password,
 		Role:         req.Role,
+		HourlyRate:   req.HourlyRate,
+		ManagerID:    req.ManagerID,
 	}
 
 	err := ctrl.UserService.CreateUser(newUser, creatorRoleStr)
@@ -146,6 +152,14 @@ func (ctrl *UserController) CreateUser(c *gin.Context) {
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 			return
 		}
+		if err.Error() == "manager not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "user cannot be their own manager" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create user: " + err.Error()})
 		return
 	}
@@ -191,14 +205,20 @@ func (ctrl *UserController) UpdateUser(c *gin.Context) {
 		Email:        req.Email,
 		PasswordHash: req.Password,
 		Role:         req.Role,
+		HourlyRate:   req.HourlyRate,
+		ManagerID:    req.ManagerID,
 	}
 
 	err := ctrl.UserService.UpdateUser(updatedUser, creatorRoleStr)
 	if err != nil {
-		if err.Error() == "user not found" {
+		if err.Error() == "user not found" || err.Error() == "manager not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
 		}
+		if err.Error() == "user cannot be their own manager" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		if err.Error() == "admin cannot update a user to 'admin' role" {
 			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 			return
@@ -0,0 +1,315 @@
+package controllers
+
+import (
+	"math"
+	"net/http"
+
+	"jaga/dto"
+	"jaga/models"
+	"jaga/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkRequestController interface {
+	CreateWorkRequest(c *gin.Context)
+	GetWorkRequests(c *gin.Context)
+	GetWorkRequestByID(c *gin.Context)
+	TriageWorkRequest(c *gin.Context)
+	MergeWorkRequest(c *gin.Context)
+	RejectWorkRequest(c *gin.Context)
+}
+
+type workRequestController struct {
+	service services.WorkRequestService
+}
+
+func NewWorkRequestController(service services.WorkRequestService) WorkRequestController {
+	return &workRequestController{service: service}
+}
+
+func toWorkRequestDTO(request models.WorkRequest) dto.WorkRequestDTO {
+	var recordID *string
+	if request.Record != nil {
+		recordID = &request.Record.ID
+	}
+	return dto.WorkRequestDTO{
+		ID:            request.ID,
+		AssetID:       request.AssetID,
+		AssetName:     request.Asset.Name,
+		RequestedBy:   request.RequestedBy,
+		RequesterName: request.Requester.Name,
+		Description:   request.Description,
+		Urgency:       request.Urgency,
+		Status:        request.Status,
+		RecordID:      recordID,
+		MergedIntoID:  request.MergedIntoID,
+		Feedback:      request.Feedback,
+		TriagedBy:     request.TriagedBy,
+		TriagedAt:     request.TriagedAt,
+		CreatedAt:     request.CreatedAt,
+		UpdatedAt:     request.UpdatedAt,
+	}
+}
+
+// CreateWorkRequest godoc
+// @Summary Submit a work request
+// @Description Report that an asset needs attention. Any role may submit a request; a photo can be added afterwards through the request's attachments. Admins then triage it into a maintenance record, merge it into an earlier request, or reject it.
+// @Tags WorkRequests
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateWorkRequestRequest true "Create Work Request Request"
+// @Success 201 {object} dto.CreateWorkRequestResponse
+// @Router /v1/work-requests [post]
+func (ctrl *workRequestController) CreateWorkRequest(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateWorkRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	request := &models.WorkRequest{
+		AssetID:     req.AssetID,
+		RequestedBy: userID,
+		Description: req.Description,
+		Urgency:     req.Urgency,
+	}
+
+	if err := ctrl.service.CreateWorkRequest(request); err != nil {
+		if err.Error() == "asset not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Asset not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to submit work request: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateWorkRequestResponse{
+		Message:     "Work request submitted successfully",
+		WorkRequest: toWorkRequestDTO(*request),
+	})
+}
+
+// GetWorkRequests godoc
+// @Summary Get work requests
+// @Description List work requests, most urgent first and then oldest first. Technicians only see the requests they submitted.
+// @Tags WorkRequests
+// @Produce json
+// @Param page query int false "Page"
+// @Param items_per_page query int false "Items per page"
+// @Param status query string false "open, triaged, merged or rejected"
+// @Param urgency query string false "low, medium, high or critical"
+// @Param asset_id query string false "Asset ID"
+// @Success 200 {object} dto.GetWorkRequestsResponse
+// @Router /v1/work-requests [get]
+func (ctrl *workRequestController) GetWorkRequests(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.GetWorkRequestsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	requests, totalItems, err := ctrl.service.GetWorkRequests(req.Page, req.ItemsPerPage, req.Status, req.Urgency, req.AssetID, userID, role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve work requests: " + err.Error()})
+		return
+	}
+
+	requestDTOs := make([]dto.WorkRequestDTO, len(requests))
+	for i, request := range requests {
+		requestDTOs[i] = toWorkRequestDTO(request)
+	}
+
+	totalPages := 0
+	if req.ItemsPerPage > 0 {
+		totalPages = int(math.Ceil(float64(totalItems) / float64(req.ItemsPerPage)))
+	}
+
+	c.JSON(http.StatusOK, dto.GetWorkRequestsResponse{
+		Message:      "Work requests retrieved successfully",
+		WorkRequests: requestDTOs,
+		TotalItems:   int(totalItems),
+		Page:         req.Page,
+		ItemsPerPage: req.ItemsPerPage,
+		TotalPages:   totalPages,
+	})
+}
+
+// GetWorkRequestByID godoc
+// @Summary Get a work request
+// @Description Retrieve a work request by its ID. Technicians only see the requests they submitted or that became records assigned to them.
+// @Tags WorkRequests
+// @Produce json
+// @Param id path string true "Work Request ID"
+// @Success 200 {object} dto.GetWorkRequestByIDResponse
+// @Router /v1/work-requests/{id} [get]
+func (ctrl *workRequestController) GetWorkRequestByID(c *gin.Context) {
+	userID, role, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	request, err := ctrl.service.GetWorkRequestByID(c.Param("id"), userID, role)
+	if err != nil {
+		switch err.Error() {
+		case "work request not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": "Work request not found"})
+		case "access to work request denied":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve work request: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetWorkRequestByIDResponse{
+		Message:     "Work request retrieved successfully",
+		WorkRequest: toWorkRequestDTO(*request),
+	})
+}
+
+// TriageWorkRequest godoc
+// @Summary Triage a work request into a maintenance record
+// @Description Accept an open work request by creating a pending maintenance record for its asset, linked back to the request. The description defaults to the request's, the priority to its urgency and the maintenance date to now. The requester is notified.
+// @Tags WorkRequests
+// @Accept json
+// @Produce json
+// @Param id path string true "Work Request ID"
+// @Param request body dto.TriageWorkRequestRequest false "Triage Work Request Request"
+// @Success 200 {object} dto.WorkRequestDecisionResponse
+// @Router /v1/work-requests/{id}/triage [post]
+func (ctrl *workRequestController) TriageWorkRequest(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.TriageWorkRequestRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+			return
+		}
+	}
+
+	record := &models.MaintenanceRecord{
+		PerformedBy: req.PerformedBy,
+		Description: req.Description,
+		Priority:    req.Priority,
+	}
+	if req.MaintenanceDate != nil {
+		record.MaintenanceDate = *req.MaintenanceDate
+	}
+
+	request, err := ctrl.service.TriageWorkRequest(c.Param("id"), record, req.Feedback, userID)
+	if err != nil {
+		switch err.Error() {
+		case "work request not found", "asset not found", "performed by not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "work request is already triaged":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to triage work request: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WorkRequestDecisionResponse{
+		Message:     "Work request triaged successfully",
+		WorkRequest: toWorkRequestDTO(*request),
+	})
+}
+
+// MergeWorkRequest godoc
+// @Summary Merge a duplicate work request
+// @Description Close an open work request as a duplicate of another request for the same asset. The requester is notified.
+// @Tags WorkRequests
+// @Accept json
+// @Produce json
+// @Param id path string true "Work Request ID"
+// @Param request body dto.MergeWorkRequestRequest true "Merge Work Request Request"
+// @Success 200 {object} dto.WorkRequestDecisionResponse
+// @Router /v1/work-requests/{id}/merge [post]
+func (ctrl *workRequestController) MergeWorkRequest(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.MergeWorkRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	request, err := ctrl.service.MergeWorkRequest(c.Param("id"), req.TargetID, req.Feedback, userID)
+	if err != nil {
+		switch err.Error() {
+		case "work request not found", "target work request not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "work request cannot be merged into itself", "work requests are for different assets":
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case "target work request is closed", "work request is already triaged":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to merge work request: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WorkRequestDecisionResponse{
+		Message:     "Work request merged successfully",
+		WorkRequest: toWorkRequestDTO(*request),
+	})
+}
+
+// RejectWorkRequest godoc
+// @Summary Reject a work request
+// @Description Close an open work request without action. The requester is notified with the feedback.
+// @Tags WorkRequests
+// @Accept json
+// @Produce json
+// @Param id path string true "Work Request ID"
+// @Param request body dto.RejectWorkRequestRequest true "Reject Work Request Request"
+// @Success 200 {object} dto.WorkRequestDecisionResponse
+// @Router /v1/work-requests/{id}/reject [post]
+func (ctrl *workRequestController) RejectWorkRequest(c *gin.Context) {
+	userID, _, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RejectWorkRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	request, err := ctrl.service.RejectWorkRequest(c.Param("id"), req.Feedback, userID)
+	if err != nil {
+		switch err.Error() {
+		case "work request not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": "Work request not found"})
+		case "work request is already triaged":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reject work request: " + err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WorkRequestDecisionResponse{
+		Message:     "Work request rejected successfully",
+		WorkRequest: toWorkRequestDTO(*request),
+	})
+}
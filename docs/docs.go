@@ -171,6 +171,142 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/asset-categories/{id}/approval-rule": {
+            "get": {
+                "description": "Get which finished records of assets in a category need a manager's sign-off. Categories without a rule need none.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Get a category's approval rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryApprovalRuleResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the approval rule of an asset category. With requires_approval, records a technician finishes go to awaiting_approval until a manager signs them off; unscheduled_only limits this to records without a schedule. Records already awaiting approval still need it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Set a category's approval rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set Category Approval Rule Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetCategoryApprovalRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryApprovalRuleResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/asset-categories/{id}/checklist": {
+            "get": {
+                "description": "List the ordered checklist steps copied onto records of assets in a category whose schedule has no checklist of its own",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Get a category's checklist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetChecklistResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the checklist of an asset category. Steps keep the order given. Records already created keep their own copy.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Set a category's checklist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set Checklist Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetChecklistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetChecklistResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/assets": {
             "get": {
                 "description": "Retrieve a list of assets with pagination and optional filters",
@@ -327,19 +463,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/assets/{id}/status": {
-            "put": {
-                "description": "Update the status of an asset by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+        "/v1/assets/{id}/attachments": {
+            "get": {
+                "description": "List the files attached to an asset",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assets"
+                    "Attachments"
                 ],
-                "summary": "Update the status of an asset",
+                "summary": "Get asset attachments",
                 "parameters": [
                     {
                         "type": "string",
@@ -347,85 +480,132 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update asset status request",
-                        "name": "statusRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateAssetStatusRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateAssetResponse"
+                            "$ref": "#/definitions/dto.GetAttachmentsResponse"
                         }
                     }
                 }
-            }
-        },
-        "/v1/login": {
+            },
             "post": {
-                "description": "Login with email and password, and receive a JWT token",
+                "description": "Attach a photo, invoice, manual or signed form to an asset. Accepts JPEG, PNG, GIF, WebP and PDF files up to the configured size limit; the type is detected from the file contents.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Attachments"
                 ],
-                "summary": "Login user",
+                "summary": "Upload an asset attachment",
                 "parameters": [
                     {
-                        "description": "Login request",
-                        "name": "loginRequest",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File to upload",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "photo_before, photo_after, invoice, manual, signed_form or other",
+                        "name": "kind",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.LoginRequest"
+                            "$ref": "#/definitions/dto.UploadAttachmentResponse"
                         }
                     }
+                }
+            }
+        },
+        "/v1/assets/{id}/cost-summary": {
+            "get": {
+                "description": "Total what an asset has cost: its purchase cost plus the labor, parts and vendor costs of its maintenance records. With a date range, both inclusive, only records dated within it count, and the purchase cost only when the asset was bought within it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Costs"
+                ],
+                "summary": "Get an asset's cost summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.LoginResponse"
+                            "$ref": "#/definitions/dto.GetAssetCostSummaryResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/maintenance-records": {
+        "/v1/assets/{id}/meters": {
             "get": {
-                "description": "Retrieve maintenance records with optional filters and pagination",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve every usage meter registered on an asset",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "MaintenanceRecords"
+                    "AssetMeters"
+                ],
+                "summary": "Get the meters of an asset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get a list of maintenance records",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetMaintenanceRecordsResponse"
+                            "$ref": "#/definitions/dto.GetAssetMetersResponse"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Create a maintenance record for an asset",
+                "description": "Register a usage meter (run hours, odometer or cycle count) on an asset",
                 "consumes": [
                     "application/json"
                 ],
@@ -433,17 +613,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MaintenanceRecords"
+                    "AssetMeters"
                 ],
-                "summary": "Create a new maintenance record",
+                "summary": "Add a meter to an asset",
                 "parameters": [
                     {
-                        "description": "Create Maintenance Record",
-                        "name": "body",
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Asset Meter Request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateMaintenanceRecordRequest"
+                            "$ref": "#/definitions/dto.CreateAssetMeterRequest"
                         }
                     }
                 ],
@@ -451,45 +638,49 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateMaintenanceRecordResponse"
+                            "$ref": "#/definitions/dto.CreateAssetMeterResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/maintenance-records/{id}": {
+        "/v1/assets/{id}/meters/{meterId}/readings": {
             "get": {
-                "description": "Retrieve a maintenance record by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve a paginated list of readings for a meter, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "MaintenanceRecords"
+                    "AssetMeters"
                 ],
-                "summary": "Get a maintenance record by ID",
+                "summary": "Get meter readings",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Maintenance Record ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Meter ID",
+                        "name": "meterId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetMaintenanceRecordByIDResponse"
+                            "$ref": "#/definitions/dto.GetMeterReadingsResponse"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update the details of an existing maintenance record",
+            "post": {
+                "description": "Record a new reading and trigger conditional schedules whose threshold it crosses. The reading is saved together with the records it triggers, or not at all",
                 "consumes": [
                     "application/json"
                 ],
@@ -497,129 +688,236 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MaintenanceRecords"
+                    "AssetMeters"
                 ],
-                "summary": "Update an existing maintenance record",
+                "summary": "Record a meter reading",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Maintenance Record ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update Maintenance Record",
-                        "name": "body",
+                        "type": "string",
+                        "description": "Meter ID",
+                        "name": "meterId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Meter Reading Request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateMaintenanceRecordRequest"
+                            "$ref": "#/definitions/dto.CreateMeterReadingRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateMaintenanceRecordResponse"
+                            "$ref": "#/definitions/dto.CreateMeterReadingResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete the maintenance record identified by the ID",
-                "consumes": [
+            }
+        },
+        "/v1/assets/{id}/status": {
+            "put": {
+                "description": "Update the status of an asset by its ID",
+                "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "MaintenanceRecords"
+                    "Assets"
                 ],
-                "summary": "Delete a maintenance record by ID",
+                "summary": "Update the status of an asset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Maintenance Record ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update asset status request",
+                        "name": "statusRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateAssetStatusRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.DeleteMaintenanceRecordResponse"
+                            "$ref": "#/definitions/dto.UpdateAssetResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/maintenance-records/{id}/status": {
-            "put": {
-                "description": "Update the status of a specific maintenance record",
-                "consumes": [
+        "/v1/attachments/{id}": {
+            "delete": {
+                "description": "Delete an attachment and its files. Technicians may only delete files they uploaded.",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Delete an attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteAttachmentResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/attachments/{id}/download": {
+            "get": {
+                "description": "Download the file of an attachment, subject to the same access rules as listing it",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "MaintenanceRecords"
+                    "Attachments"
                 ],
-                "summary": "Update the status of a maintenance record",
+                "summary": "Download an attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Maintenance Record ID",
+                        "description": "Attachment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/attachments/{id}/thumbnail": {
+            "get": {
+                "description": "Get the JPEG thumbnail of an image attachment",
+                "produces": [
+                    "image/jpeg"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Get an attachment thumbnail",
+                "parameters": [
                     {
-                        "description": "Update Maintenance Record Status",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateMaintenanceRecordStatusRequest"
+                            "type": "file"
                         }
                     }
+                }
+            }
+        },
+        "/v1/calendar-feeds/{token}/maintenance.ics": {
+            "get": {
+                "description": "iCalendar feed of the token owner's assigned schedules and open maintenance records. Authenticated by the token in the URL instead of a JWT so calendar apps can subscribe to it.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "CalendarFeeds"
+                ],
+                "summary": "Get a calendar feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Calendar feed token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateMaintenanceRecordResponse"
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/v1/maintenance-schedules": {
+        "/v1/failure-codes": {
             "get": {
-                "description": "Retrieve a paginated list of maintenance schedules",
+                "description": "Retrieve the failure taxonomy, optionally of one type. Deactivated codes are left out unless include_inactive is set.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "MaintenanceSchedules"
+                    "FailureCodes"
+                ],
+                "summary": "Get failure codes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "problem, cause or remedy",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include deactivated codes",
+                        "name": "include_inactive",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get maintenance schedules",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetMaintenanceSchedulesResponse"
+                            "$ref": "#/definitions/dto.GetFailureCodesResponse"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Create a new maintenance schedule for a specific asset",
+                "description": "Add a problem, cause or remedy code to the failure taxonomy. Codes are unique per type.",
                 "consumes": [
                     "application/json"
                 ],
@@ -627,17 +925,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MaintenanceSchedules"
+                    "FailureCodes"
                 ],
-                "summary": "Create a new maintenance schedule",
+                "summary": "Create a failure code",
                 "parameters": [
                     {
-                        "description": "Create Maintenance Schedule Request",
+                        "description": "Create Failure Code Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateMaintenanceScheduleRequest"
+                            "$ref": "#/definitions/dto.CreateFailureCodeRequest"
                         }
                     }
                 ],
@@ -645,26 +943,26 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateMaintenanceScheduleResponse"
+                            "$ref": "#/definitions/dto.CreateFailureCodeResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/maintenance-schedules/{id}": {
+        "/v1/failure-codes/{id}": {
             "get": {
-                "description": "Retrieve a maintenance schedule using its ID",
+                "description": "Retrieve a failure code by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "MaintenanceSchedules"
+                    "FailureCodes"
                 ],
-                "summary": "Get a maintenance schedule by ID",
+                "summary": "Get a failure code",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Maintenance Schedule ID",
+                        "description": "Failure Code ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -674,35 +972,38 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetMaintenanceScheduleByIDResponse"
+                            "$ref": "#/definitions/dto.GetFailureCodeByIDResponse"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Update an existing maintenance schedule by its ID",
+                "description": "Change a failure code's code, name or description, or deactivate it so it can no longer be put on records. Its type cannot change.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "MaintenanceSchedules"
+                    "FailureCodes"
                 ],
-                "summary": "Update a maintenance schedule",
+                "summary": "Update a failure code",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Maintenance Schedule ID",
+                        "description": "Failure Code ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update Maintenance Schedule Request",
+                        "description": "Update Failure Code Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateMaintenanceScheduleRequest"
+                            "$ref": "#/definitions/dto.UpdateFailureCodeRequest"
                         }
                     }
                 ],
@@ -710,24 +1011,24 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateMaintenanceScheduleResponse"
+                            "$ref": "#/definitions/dto.UpdateFailureCodeResponse"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Delete an existing maintenance schedule by its ID",
+                "description": "Delete a failure code no maintenance record carries. Codes in use can only be deactivated.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "MaintenanceSchedules"
+                    "FailureCodes"
                 ],
-                "summary": "Delete a maintenance schedule",
+                "summary": "Delete a failure code",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Maintenance Schedule ID",
+                        "description": "Failure Code ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -737,36 +1038,33 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.DeleteMaintenanceScheduleResponse"
+                            "$ref": "#/definitions/dto.DeleteFailureCodeResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/users": {
+        "/v1/holiday-calendars": {
             "get": {
-                "description": "Retrieve list of users",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve all holiday calendars",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "HolidayCalendars"
                 ],
-                "summary": "Get list of users",
+                "summary": "Get holiday calendars",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetUsersResponse"
+                            "$ref": "#/definitions/dto.GetHolidayCalendarsResponse"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Create a new user with a specified role and credentials",
+                "description": "Create a calendar of working days and holidays that schedules can follow. Working days default to Monday to Friday.",
                 "consumes": [
                     "application/json"
                 ],
@@ -774,17 +1072,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "HolidayCalendars"
                 ],
-                "summary": "Create a new user",
+                "summary": "Create a holiday calendar",
                 "parameters": [
                     {
-                        "description": "Create User",
-                        "name": "user",
+                        "description": "Create Holiday Calendar Request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateUserRequest"
+                            "$ref": "#/definitions/dto.CreateHolidayCalendarRequest"
                         }
                     }
                 ],
@@ -792,29 +1090,26 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateUserResponse"
+                            "$ref": "#/definitions/dto.CreateHolidayCalendarResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/users/{id}": {
+        "/v1/holiday-calendars/{id}": {
             "get": {
-                "description": "Retrieve user details by their unique ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve a holiday calendar by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "HolidayCalendars"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Get a holiday calendar",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Holiday Calendar ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -824,13 +1119,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetUserByIDResponse"
+                            "$ref": "#/definitions/dto.GetHolidayCalendarByIDResponse"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Update an existing user's information",
+                "description": "Rename a holiday calendar or change its working days",
                 "consumes": [
                     "application/json"
                 ],
@@ -838,312 +1133,5820 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "HolidayCalendars"
                 ],
-                "summary": "Update user information",
+                "summary": "Update a holiday calendar",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Holiday Calendar ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update User",
-                        "name": "user",
+                        "description": "Update Holiday Calendar Request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                            "$ref": "#/definitions/dto.UpdateHolidayCalendarRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserResponse"
+                            "$ref": "#/definitions/dto.UpdateHolidayCalendarResponse"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Delete a user by their unique ID",
-                "consumes": [
+                "description": "Delete a holiday calendar and its holidays. Calendars still used by schedules cannot be deleted.",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "HolidayCalendars"
+                ],
+                "summary": "Delete a holiday calendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Holiday Calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteHolidayCalendarResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/holiday-calendars/{id}/holidays": {
+            "get": {
+                "description": "List the holidays of a calendar, optionally within a date range",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "HolidayCalendars"
                 ],
-                "summary": "Delete user",
+                "summary": "Get the holidays of a calendar",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Holiday Calendar ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date, inclusive (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.DeleteUserResponse"
+                            "$ref": "#/definitions/dto.GetHolidaysResponse"
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "dto.AssetCategoryDTO": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
+            },
+            "post": {
+                "description": "Add a holiday to a calendar. A holiday already on that date is renamed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HolidayCalendars"
+                ],
+                "summary": "Add a holiday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Holiday Calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Holiday Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateHolidayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateHolidayResponse"
+                        }
+                    }
                 }
             }
         },
-        "dto.AssetDTO": {
-            "type": "object",
-            "properties": {
-                "added_by": {
+        "/v1/holiday-calendars/{id}/holidays/{holidayId}": {
+            "delete": {
+                "description": "Remove a holiday from a calendar",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HolidayCalendars"
+                ],
+                "summary": "Delete a holiday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Holiday Calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Holiday ID",
+                        "name": "holidayId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteHolidayResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/holiday-calendars/{id}/import": {
+            "post": {
+                "description": "Add every day covered by the events of an .ics file to the calendar. Send the file as the multipart field \"file\" or as a text/calendar request body.",
+                "consumes": [
+                    "multipart/form-data",
+                    "text/calendar"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HolidayCalendars"
+                ],
+                "summary": "Import holidays from an iCalendar file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Holiday Calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "iCalendar file",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportHolidaysResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/login": {
+            "post": {
+                "description": "Login with email and password, and receive a JWT token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Login user",
+                "parameters": [
+                    {
+                        "description": "Login request",
+                        "name": "loginRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-calendar": {
+            "get": {
+                "description": "Expand schedules into occurrences within a date range and merge them with existing maintenance records. Each entry is planned, generated, done, missed or paused.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceCalendar"
+                ],
+                "summary": "Get the maintenance calendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date, inclusive (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Technician user ID",
+                        "name": "assigned_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "asset_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetMaintenanceCalendarResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records": {
+            "get": {
+                "description": "Retrieve maintenance records with optional filters and pagination. Besides its columns, the list can be sorted by priority, most urgent first when descending, and by sla_deadline, the record's next response or resolution deadline, with records without deadlines last.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceRecords"
+                ],
+                "summary": "Get a list of maintenance records",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Column, priority or sla_deadline",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "sort_dir",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetMaintenanceRecordsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a maintenance record for an asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceRecords"
+                ],
+                "summary": "Create a new maintenance record",
+                "parameters": [
+                    {
+                        "description": "Create Maintenance Record",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateMaintenanceRecordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateMaintenanceRecordResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/rebalance": {
+            "post": {
+                "description": "Reassign pending maintenance records across technicians by open-record load and category affinity. Only pending records are moved; records in progress or on hold stay with their technician but count towards the load. The reassignments are saved together or not at all.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assignment"
+                ],
+                "summary": "Rebalance open maintenance records",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only report the resulting load",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RebalanceMaintenanceRecordsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}": {
+            "get": {
+                "description": "Retrieve a maintenance record by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceRecords"
+                ],
+                "summary": "Get a maintenance record by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetMaintenanceRecordByIDResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update the details of an existing maintenance record. Fields left out of the body keep their current values; an empty schedule_id or performed_by clears that link.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceRecords"
+                ],
+                "summary": "Update an existing maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Maintenance Record",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateMaintenanceRecordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateMaintenanceRecordResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete the maintenance record identified by the ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceRecords"
+                ],
+                "summary": "Delete a maintenance record by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteMaintenanceRecordResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/approvals": {
+            "get": {
+                "description": "List every approval and rejection of a maintenance record, oldest first, with who decided and why",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Get the approval decisions of a maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetRecordApprovalsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/approve": {
+            "post": {
+                "description": "Sign off a maintenance record awaiting approval. The record finishes: its parts are taken out of stock, its schedule advances and the technician is notified. Nobody may approve their own work.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Approve a maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Approve Maintenance Record Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ApproveMaintenanceRecordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ApprovalDecisionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/attachments": {
+            "get": {
+                "description": "List the files attached to a maintenance record. Technicians only see files of records assigned to them or unassigned records.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Get maintenance record attachments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetAttachmentsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Attach a photo, invoice, manual or signed form to a maintenance record. Accepts JPEG, PNG, GIF, WebP and PDF files up to the configured size limit; the type is detected from the file contents. Technicians may only upload to records assigned to them or unassigned records.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Upload a maintenance record attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File to upload",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "photo_before, photo_after, invoice, manual, signed_form or other",
+                        "name": "kind",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UploadAttachmentResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/checklist": {
+            "get": {
+                "description": "List the checklist steps of a maintenance record and their completion",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Get a record's checklist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetRecordChecklistResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/checklist/{itemId}": {
+            "put": {
+                "description": "Tick a checklist step of an open maintenance record off, or untick it, with an optional note and measured value. Technicians may only update records assigned to them or unassigned records.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Update a checklist step of a record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Checklist Item ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Checklist Item Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateRecordChecklistItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateRecordChecklistItemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/comments": {
+            "get": {
+                "description": "List the comment thread of a maintenance record, oldest first. Technicians only see the threads of records assigned to them or unassigned records.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Get maintenance record comments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetRecordCommentsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Append a comment to a maintenance record's thread. Mention users with @ followed by their email address, e.g. @jane@example.com, to notify them. Technicians may comment on records assigned to them or unassigned records.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Comment on a maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Comment Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateRecordCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateRecordCommentResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/comments/{commentId}": {
+            "put": {
+                "description": "Change the text of a comment. Only its author may, within the configured grace window after posting. Users newly mentioned by the edit are notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Edit a maintenance record comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Comment Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateRecordCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateRecordCommentResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/costs": {
+            "get": {
+                "description": "List the cost lines of a maintenance record: stopped labor at each user's hourly rate when it was logged, parts at their unit cost when added (left out for cancelled records) and vendor invoices",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Costs"
+                ],
+                "summary": "Get maintenance record costs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetRecordCostsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/failure-codes": {
+            "put": {
+                "description": "Set the problem, cause and remedy codes of a maintenance record. An omitted code is kept and an empty one is cleared. Records with a problem code count as failures in the reliability report. Technicians may only classify records assigned to them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FailureCodes"
+                ],
+                "summary": "Classify a maintenance record's failure",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set Record Failure Codes Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetRecordFailureCodesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetRecordFailureCodesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/history": {
+            "get": {
+                "description": "Retrieve every status change of a maintenance record, oldest first, with who made it and the optional comment. The first entry records the status the record was created in and has an empty from_status; records generated by the scheduler or a meter reading have no actor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceRecords"
+                ],
+                "summary": "Get the status history of a maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetMaintenanceRecordHistoryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/labor": {
+            "get": {
+                "description": "List the labor entries of a maintenance record with its total hours. Running timers count up to now.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labor"
+                ],
+                "summary": "Get labor entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetLaborEntriesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Log time spent on a maintenance record without a timer. Admins may log time for another user through user_id.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labor"
+                ],
+                "summary": "Log labor time",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Log Labor Entry Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LogLaborEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LogLaborEntryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/labor/start": {
+            "post": {
+                "description": "Start the authenticated user's timer on a maintenance record in progress. Several technicians may time the same record, but each user runs one timer at a time. Timers stop automatically when the record leaves in_progress.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labor"
+                ],
+                "summary": "Start a labor timer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Start Labor Timer Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartLaborTimerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LaborTimerResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/labor/stop": {
+            "post": {
+                "description": "Stop the authenticated user's running timer on a maintenance record",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labor"
+                ],
+                "summary": "Stop a labor timer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LaborTimerResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/labor/{entryId}": {
+            "delete": {
+                "description": "Delete a labor entry from a maintenance record. Technicians may only delete their own entries.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labor"
+                ],
+                "summary": "Delete a labor entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Labor Entry ID",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteLaborEntryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/parts": {
+            "get": {
+                "description": "List the parts used on a maintenance record and whether they have been taken out of stock yet",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parts"
+                ],
+                "summary": "Get parts used on a maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetRecordPartsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Record a quantity of a part used on an open maintenance record. The quantity is taken out of the given stock location when the record finishes and put back if it is cancelled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parts"
+                ],
+                "summary": "Add a part to a maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Add Record Part Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AddRecordPartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AddRecordPartResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/parts/{partUsageId}": {
+            "delete": {
+                "description": "Remove a part usage from an open maintenance record before it is taken out of stock",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parts"
+                ],
+                "summary": "Remove a part from a maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Record Part ID",
+                        "name": "partUsageId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RemoveRecordPartResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/reject": {
+            "post": {
+                "description": "Send a maintenance record awaiting approval back to in_progress. The technician is notified with the reason.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Reject a maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reject Maintenance Record Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RejectMaintenanceRecordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ApprovalDecisionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/status": {
+            "put": {
+                "description": "Update the status of a specific maintenance record. Changes not allowed by the record status state machine are rejected with 409 Conflict. Technicians may only update records assigned to them; updating an unassigned record claims it. Finishing a record takes its parts out of stock and fails with 409 Conflict when a location does not hold enough; cancelling it puts them back and skips the schedule occurrence it was generated for. Admins may cancel a finished record to reverse it, which also puts its parts back. Running labor timers stop when the record leaves in_progress. When the asset category's approval rule asks for sign-off, a technician finishing the record puts it in awaiting_approval instead; a record awaiting approval is finished only by approving it. The first move to in_progress is the record's SLA response and finishing it its resolution; either one after its deadline flags the record as breached.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceRecords"
+                ],
+                "summary": "Update the status of a maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Maintenance Record Status",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateMaintenanceRecordStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateMaintenanceRecordResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/vendor-invoices": {
+            "get": {
+                "description": "List the external vendor invoices charged to a maintenance record",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Costs"
+                ],
+                "summary": "Get vendor invoices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetVendorInvoicesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Charge an external vendor's invoice to a maintenance record",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Costs"
+                ],
+                "summary": "Add a vendor invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Vendor Invoice Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateVendorInvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateVendorInvoiceResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-records/{id}/vendor-invoices/{invoiceId}": {
+            "delete": {
+                "description": "Remove a vendor invoice from a maintenance record",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Costs"
+                ],
+                "summary": "Delete a vendor invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vendor Invoice ID",
+                        "name": "invoiceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteVendorInvoiceResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-schedules": {
+            "get": {
+                "description": "Retrieve a paginated list of maintenance schedules",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Get maintenance schedules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetMaintenanceSchedulesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new maintenance schedule for a specific asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Create a new maintenance schedule",
+                "parameters": [
+                    {
+                        "description": "Create Maintenance Schedule Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateMaintenanceScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateMaintenanceScheduleResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-schedules/overdue": {
+            "get": {
+                "description": "List schedules whose due date has passed without a finished record, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Get overdue maintenance schedules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetOverdueMaintenanceSchedulesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-schedules/preview-occurrences": {
+            "post": {
+                "description": "List the first occurrences of an RFC 5545 RRULE before saving it on a schedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Preview a recurrence rule",
+                "parameters": [
+                    {
+                        "description": "Preview Recurrence Rule Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PreviewRecurrenceRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetUpcomingOccurrencesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-schedules/{id}": {
+            "get": {
+                "description": "Retrieve a maintenance schedule using its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Get a maintenance schedule by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetMaintenanceScheduleByIDResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing maintenance schedule by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Update a maintenance schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Maintenance Schedule Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateMaintenanceScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateMaintenanceScheduleResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing maintenance schedule by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Delete a maintenance schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteMaintenanceScheduleResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-schedules/{id}/checklist": {
+            "get": {
+                "description": "List the ordered checklist steps copied onto records generated from a maintenance schedule",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Get a schedule's checklist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetChecklistResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the checklist of a maintenance schedule. Steps keep the order given. Records already generated keep their own copy.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Set a schedule's checklist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set Checklist Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetChecklistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetChecklistResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-schedules/{id}/occurrences": {
+            "get": {
+                "description": "List the next due dates of a schedule, computed from its recurrence rule or interval",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Preview the next occurrences of a maintenance schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of occurrences (1-100, default 5)",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetUpcomingOccurrencesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-schedules/{id}/resume": {
+            "post": {
+                "description": "Lift the suspension of a schedule. skip_missed keeps the cadence and drops occurrences missed while suspended, restart_from_resume starts a new cycle now, and keep leaves the next maintenance date unchanged. Defaults to the policy chosen when suspending.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Resume a maintenance schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resume Maintenance Schedule Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResumeMaintenanceScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResumeMaintenanceScheduleResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance-schedules/{id}/suspend": {
+            "post": {
+                "description": "Pause a schedule, e.g. while its asset is mothballed or off-site. Suspended schedules generate no records and are never overdue. A schedule with a suspended-until date resumes automatically using the chosen resume policy.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Suspend a maintenance schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Suspend Maintenance Schedule Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuspendMaintenanceScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuspendMaintenanceScheduleResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/calendar-feed-tokens": {
+            "get": {
+                "description": "List your calendar feed tokens, including revoked ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CalendarFeeds"
+                ],
+                "summary": "List calendar feed tokens",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetCalendarFeedTokensResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Issue a token for subscribing to your maintenance work from a calendar app. The feed URL is only shown once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CalendarFeeds"
+                ],
+                "summary": "Create a calendar feed token",
+                "parameters": [
+                    {
+                        "description": "Create Calendar Feed Token Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateCalendarFeedTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateCalendarFeedTokenResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/calendar-feed-tokens/{id}": {
+            "delete": {
+                "description": "Revoke one of your calendar feed tokens; subscriptions using it stop working immediately",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CalendarFeeds"
+                ],
+                "summary": "Revoke a calendar feed token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RevokeCalendarFeedTokenResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/maintenance-records": {
+            "get": {
+                "description": "Retrieve the maintenance records performed by the authenticated user, with optional filters and pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceRecords"
+                ],
+                "summary": "Get my maintenance records",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetMaintenanceRecordsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/maintenance-schedules": {
+            "get": {
+                "description": "Retrieve a paginated list of the maintenance schedules assigned to the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MaintenanceSchedules"
+                ],
+                "summary": "Get my maintenance schedules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetMaintenanceSchedulesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/notifications": {
+            "get": {
+                "description": "Retrieve the authenticated user's notifications, newest first, with the number still unread",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get my notifications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "items_per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only unread notifications",
+                        "name": "unread_only",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetNotificationsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/notifications/read-all": {
+            "post": {
+                "description": "Mark every unread notification of the authenticated user as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark all notifications as read",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MarkNotificationReadResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/notifications/{id}/read": {
+            "post": {
+                "description": "Mark one of the authenticated user's notifications as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MarkNotificationReadResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/parts": {
+            "get": {
+                "description": "Retrieve parts with their stock, optionally searched by name or SKU and filtered by asset category. Technicians only see parts without categories and parts for the assets of their open maintenance records.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parts"
+                ],
+                "summary": "Get parts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "items_per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name or SKU",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by asset category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetPartsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a spare part. Parts linked to asset categories are only offered for assets of those categories; parts without categories fit every asset.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parts"
+                ],
+                "summary": "Create a part",
+                "parameters": [
+                    {
+                        "description": "Create Part Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatePartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatePartResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/parts/low-stock": {
+            "get": {
+                "description": "List the parts whose total stock over all locations is at or below their low-stock threshold",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parts"
+                ],
+                "summary": "Get low-stock alerts",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetLowStockPartsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/parts/{id}": {
+            "get": {
+                "description": "Retrieve a part and its stock by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parts"
+                ],
+                "summary": "Get a part",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Part ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetPartByIDResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a part's details, low-stock threshold or asset categories. Omitted fields are left unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parts"
+                ],
+                "summary": "Update a part",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Part ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Part Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdatePartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdatePartResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a part and its stock. Parts that were used on a maintenance record cannot be deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parts"
+                ],
+                "summary": "Delete a part",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Part ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeletePartResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/parts/{id}/stock/{locationId}": {
+            "put": {
+                "description": "Set the quantity of a part on hand at a stock location, e.g. after a delivery or a stock count",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parts"
+                ],
+                "summary": "Set part stock",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Part ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Stock Location ID",
+                        "name": "locationId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set Part Stock Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetPartStockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetPartStockResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reports/category-costs": {
+            "get": {
+                "description": "Total per asset category the purchase cost of the assets bought between two dates, both inclusive, and the labor, parts and vendor costs of the maintenance records dated within them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reports"
+                ],
+                "summary": "Get a cost rollup per asset category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetCategoryCostRollupResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reports/labor-hours": {
+            "get": {
+                "description": "Total the labor hours started between two dates, both inclusive, per maintenance record, asset or technician. Running timers are left out until they are stopped.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reports"
+                ],
+                "summary": "Get a labor hours report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "record, asset or technician",
+                        "name": "group_by",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetLaborHoursReportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reports/reliability": {
+            "get": {
+                "description": "Compute MTBF and MTTR per asset or asset category between two dates, both inclusive. Failures are the records with a problem code dated within the range, cancelled ones aside. A repair runs from the record's first move to in_progress until it finished. MTBF divides the time in service, less repairs, by the number of failures; both are null when there is nothing to average.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reports"
+                ],
+                "summary": "Get a reliability report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "asset or category",
+                        "name": "group_by",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only assets of this category",
+                        "name": "category_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetReliabilityReportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sla-policies": {
+            "get": {
+                "description": "List the response and resolution times of every priority that has a policy, most urgent first. Records of a priority without a policy have no deadlines.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SLAPolicies"
+                ],
+                "summary": "Get the SLA policies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetSLAPoliciesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sla-policies/{priority}": {
+            "put": {
+                "description": "Set how many minutes records of a priority may take to be responded to, moved to in_progress, and resolved, finished. Both count from when the record is created, or from its maintenance date when that is later. New records, and records whose priority or maintenance date changes, get deadlines from the policy; existing deadlines are kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SLAPolicies"
+                ],
+                "summary": "Set the SLA policy of a priority",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "low, medium, high or critical",
+                        "name": "priority",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set SLA Policy Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetSLAPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SLAPolicyResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stop giving deadlines to new records of a priority. Existing deadlines are kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SLAPolicies"
+                ],
+                "summary": "Delete the SLA policy of a priority",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "low, medium, high or critical",
+                        "name": "priority",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteSLAPolicyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stock-locations": {
+            "get": {
+                "description": "Retrieve all stock locations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StockLocations"
+                ],
+                "summary": "Get stock locations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetStockLocationsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a place where parts are kept, such as a warehouse or a service van",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StockLocations"
+                ],
+                "summary": "Create a stock location",
+                "parameters": [
+                    {
+                        "description": "Create Stock Location Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateStockLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateStockLocationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stock-locations/{id}": {
+            "get": {
+                "description": "Retrieve a stock location by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StockLocations"
+                ],
+                "summary": "Get a stock location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stock Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetStockLocationByIDResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename a stock location or change its description",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StockLocations"
+                ],
+                "summary": "Update a stock location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stock Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Stock Location Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateStockLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateStockLocationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a stock location that holds no stock and was never used on a maintenance record",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StockLocations"
+                ],
+                "summary": "Delete a stock location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stock Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteStockLocationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users": {
+            "get": {
+                "description": "Retrieve list of users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get list of users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetUsersResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new user with a specified role and credentials",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "Create User",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}": {
+            "get": {
+                "description": "Retrieve user details by their unique ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetUserByIDResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing user's information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update user information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update User",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a user by their unique ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/categories": {
+            "get": {
+                "description": "List the asset categories a technician is preferred for when work is assigned automatically",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assignment"
+                ],
+                "summary": "Get a technician's categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Technician user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetTechnicianCategoriesResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the asset categories a technician is preferred for when work is assigned automatically",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assignment"
+                ],
+                "summary": "Set a technician's categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Technician user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set Technician Categories Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetTechnicianCategoriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetTechnicianCategoriesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/work-requests": {
+            "get": {
+                "description": "List work requests, most urgent first and then oldest first. Technicians only see the requests they submitted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WorkRequests"
+                ],
+                "summary": "Get work requests",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page",
+                        "name": "items_per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "open, triaged, merged or rejected",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "low, medium, high or critical",
+                        "name": "urgency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "asset_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetWorkRequestsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Report that an asset needs attention. Any role may submit a request; a photo can be added afterwards through the request's attachments. Admins then triage it into a maintenance record, merge it into an earlier request, or reject it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WorkRequests"
+                ],
+                "summary": "Submit a work request",
+                "parameters": [
+                    {
+                        "description": "Create Work Request Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateWorkRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateWorkRequestResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/work-requests/{id}": {
+            "get": {
+                "description": "Retrieve a work request by its ID. Technicians only see the requests they submitted or that became records assigned to them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WorkRequests"
+                ],
+                "summary": "Get a work request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Work Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetWorkRequestByIDResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/work-requests/{id}/attachments": {
+            "get": {
+                "description": "List the files attached to a work request. Technicians only see files of the requests they submitted or that became records assigned to them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Get work request attachments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Work Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetAttachmentsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Attach a photo of the problem to a work request. Accepts JPEG, PNG, GIF, WebP and PDF files up to the configured size limit; the type is detected from the file contents. Technicians may only upload to requests they can see.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Upload a work request attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Work Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File to upload",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "photo_before, photo_after, invoice, manual, signed_form or other",
+                        "name": "kind",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UploadAttachmentResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/work-requests/{id}/merge": {
+            "post": {
+                "description": "Close an open work request as a duplicate of another request for the same asset. The requester is notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WorkRequests"
+                ],
+                "summary": "Merge a duplicate work request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Work Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Merge Work Request Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MergeWorkRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorkRequestDecisionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/work-requests/{id}/reject": {
+            "post": {
+                "description": "Close an open work request without action. The requester is notified with the feedback.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WorkRequests"
+                ],
+                "summary": "Reject a work request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Work Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reject Work Request Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RejectWorkRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorkRequestDecisionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/work-requests/{id}/triage": {
+            "post": {
+                "description": "Accept an open work request by creating a pending maintenance record for its asset, linked back to the request. The description defaults to the request's, the priority to its urgency and the maintenance date to now. The requester is notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WorkRequests"
+                ],
+                "summary": "Triage a work request into a maintenance record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Work Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Triage Work Request Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TriageWorkRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorkRequestDecisionResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.AddRecordPartRequest": {
+            "type": "object",
+            "required": [
+                "location_id",
+                "part_id",
+                "quantity"
+            ],
+            "properties": {
+                "location_id": {
+                    "type": "string"
+                },
+                "part_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.AddRecordPartResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "record_part": {
+                    "$ref": "#/definitions/dto.RecordPartDTO"
+                }
+            }
+        },
+        "dto.ApprovalDecisionResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ApproveMaintenanceRecordRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "dto.AssetCategoryDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AssetCostSummaryDTO": {
+            "type": "object",
+            "properties": {
+                "asset_id": {
+                    "type": "string"
+                },
+                "asset_name": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "maintenance": {
+                    "$ref": "#/definitions/dto.MaintenanceCostsDTO"
+                },
+                "purchase_cost": {
+                    "type": "number"
+                },
+                "purchase_date": {
+                    "type": "string"
+                },
+                "total_cost": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.AssetDTO": {
+            "type": "object",
+            "properties": {
+                "added_by": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "condition": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_maintenance_date": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "purchase_cost": {
+                    "type": "number"
+                },
+                "purchase_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_changed_at": {
+                    "type": "string"
+                },
+                "status_reason": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AssetMeterDTO": {
+            "type": "object",
+            "properties": {
+                "asset_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "current_value": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_reading_at": {
+                    "type": "string"
+                },
+                "meter_type": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AttachmentDTO": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "download_url": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "owner_type": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "uploaded_by_user_id": {
+                    "type": "string"
+                },
+                "uploaded_by_user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CalendarFeedTokenDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CategoryApprovalRuleDTO": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "requires_approval": {
+                    "type": "boolean"
+                },
+                "unscheduled_only": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.CategoryApprovalRuleResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "rule": {
+                    "$ref": "#/definitions/dto.CategoryApprovalRuleDTO"
+                }
+            }
+        },
+        "dto.CategoryCostDTO": {
+            "type": "object",
+            "properties": {
+                "assets_purchased": {
+                    "type": "integer"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "maintenance": {
+                    "$ref": "#/definitions/dto.MaintenanceCostsDTO"
+                },
+                "purchase_cost": {
+                    "type": "number"
+                },
+                "total_cost": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.ChecklistTemplateItemDTO": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ChecklistTemplateItemRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "unit": {
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "dto.CostLineDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "reference_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unit_cost": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CreateAssetCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                }
+            }
+        },
+        "dto.CreateAssetCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateAssetMeterRequest": {
+            "type": "object",
+            "required": [
+                "meter_type",
+                "name"
+            ],
+            "properties": {
+                "initial_value": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "meter_type": {
+                    "type": "string",
+                    "enum": [
+                        "run_hours",
+                        "odometer",
+                        "cycle_count"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                },
+                "unit": {
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "dto.CreateAssetMeterResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "meter": {
+                    "$ref": "#/definitions/dto.AssetMeterDTO"
+                }
+            }
+        },
+        "dto.CreateAssetRequest": {
+            "type": "object",
+            "required": [
+                "added_by",
+                "category_id",
+                "name",
+                "status"
+            ],
+            "properties": {
+                "added_by": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "condition": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "last_maintenance_date": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                },
+                "purchase_cost": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "purchase_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ready",
+                        "under_maintenance",
+                        "need_maintenance"
+                    ]
+                }
+            }
+        },
+        "dto.CreateAssetResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateCalendarFeedTokenRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.CreateCalendarFeedTokenResponse": {
+            "type": "object",
+            "properties": {
+                "feed_url": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "token": {
+                    "$ref": "#/definitions/dto.CalendarFeedTokenDTO"
+                }
+            }
+        },
+        "dto.CreateFailureCodeRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "problem",
+                        "cause",
+                        "remedy"
+                    ]
+                }
+            }
+        },
+        "dto.CreateFailureCodeResponse": {
+            "type": "object",
+            "properties": {
+                "failure_code": {
+                    "$ref": "#/definitions/dto.FailureCodeDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateHolidayCalendarRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "working_days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreateHolidayCalendarResponse": {
+            "type": "object",
+            "properties": {
+                "holiday_calendar": {
+                    "$ref": "#/definitions/dto.HolidayCalendarDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateHolidayRequest": {
+            "type": "object",
+            "required": [
+                "date"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.CreateHolidayResponse": {
+            "type": "object",
+            "properties": {
+                "holiday": {
+                    "$ref": "#/definitions/dto.HolidayDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateMaintenanceRecordRequest": {
+            "type": "object",
+            "required": [
+                "asset_id",
+                "description",
+                "maintenance_date",
+                "status"
+            ],
+            "properties": {
+                "asset_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 5
+                },
+                "maintenance_date": {
+                    "type": "string"
+                },
+                "performed_by": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ]
+                },
+                "schedule_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "in_progress",
+                        "on_hold",
+                        "finished",
+                        "failed",
+                        "cancelled"
+                    ]
+                }
+            }
+        },
+        "dto.CreateMaintenanceRecordResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateMaintenanceScheduleRequest": {
+            "type": "object",
+            "required": [
+                "asset_id",
+                "next_maintenance_date",
+                "schedule_type"
+            ],
+            "properties": {
+                "advance_from": {
+                    "type": "string",
+                    "enum": [
+                        "due_date",
+                        "completion_date"
+                    ]
+                },
+                "asset_id": {
+                    "type": "string"
+                },
+                "assigned_to": {
+                    "type": "string"
+                },
+                "closed_day_policy": {
+                    "type": "string",
+                    "enum": [
+                        "shift_forward",
+                        "shift_backward",
+                        "keep"
+                    ]
+                },
+                "holiday_calendar_id": {
+                    "type": "string"
+                },
+                "interval_days": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "meter_id": {
+                    "type": "string"
+                },
+                "meter_interval": {
+                    "type": "number"
+                },
+                "meter_threshold": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "next_maintenance_date": {
+                    "type": "string"
+                },
+                "recurrence_rule": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "schedule_type": {
+                    "type": "string",
+                    "enum": [
+                        "periodic",
+                        "conditional"
+                    ]
+                },
+                "scheduled_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateMaintenanceScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateMeterReadingRequest": {
+            "type": "object",
+            "properties": {
+                "read_at": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.CreateMeterReadingResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "triggered_records": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CreatePartRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "sku",
+                "unit"
+            ],
+            "properties": {
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "low_stock_threshold": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "sku": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "unit": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "unit_cost": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.CreatePartResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "part": {
+                    "$ref": "#/definitions/dto.PartDTO"
+                }
+            }
+        },
+        "dto.CreateRecordCommentRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000
+                }
+            }
+        },
+        "dto.CreateRecordCommentResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/dto.RecordCommentDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateStockLocationRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.CreateStockLocationResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "stock_location": {
+                    "$ref": "#/definitions/dto.StockLocationDTO"
+                }
+            }
+        },
+        "dto.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "hourly_rate": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "manager_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "super_user",
+                        "admin",
+                        "technician",
+                        "manager"
+                    ]
+                }
+            }
+        },
+        "dto.CreateUserResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateVendorInvoiceRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "invoice_date",
+                "vendor"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "invoice_date": {
+                    "type": "string"
+                },
+                "invoice_number": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "vendor": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.CreateVendorInvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "vendor_invoice": {
+                    "$ref": "#/definitions/dto.VendorInvoiceDTO"
+                }
+            }
+        },
+        "dto.CreateWorkRequestRequest": {
+            "type": "object",
+            "required": [
+                "asset_id",
+                "description",
+                "urgency"
+            ],
+            "properties": {
+                "asset_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "minLength": 5
+                },
+                "urgency": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ]
+                }
+            }
+        },
+        "dto.CreateWorkRequestResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "work_request": {
+                    "$ref": "#/definitions/dto.WorkRequestDTO"
+                }
+            }
+        },
+        "dto.DeleteAssetCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteAssetResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteFailureCodeResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteHolidayCalendarResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteHolidayResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteLaborEntryResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteMaintenanceRecordResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteMaintenanceScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeletePartResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteSLAPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteStockLocationResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteUserResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteVendorInvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FailureCodeDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetAssetByIDResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "$ref": "#/definitions/dto.AssetDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetAssetCategoriesResponse": {
+            "type": "object",
+            "properties": {
+                "asset_categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AssetCategoryDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetAssetCategoryByIDResponse": {
+            "type": "object",
+            "properties": {
+                "asset_category": {
+                    "$ref": "#/definitions/dto.AssetCategoryDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetAssetCostSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "cost_summary": {
+                    "$ref": "#/definitions/dto.AssetCostSummaryDTO"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetAssetMetersResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "meters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AssetMeterDTO"
+                    }
+                }
+            }
+        },
+        "dto.GetAssetsResponse": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AssetDTO"
+                    }
+                },
+                "items_per_page": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.GetAttachmentsResponse": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AttachmentDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetCalendarFeedTokensResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "tokens": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CalendarFeedTokenDTO"
+                    }
+                }
+            }
+        },
+        "dto.GetCategoryCostRollupResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryCostDTO"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total_cost": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.GetChecklistResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ChecklistTemplateItemDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetFailureCodeByIDResponse": {
+            "type": "object",
+            "properties": {
+                "failure_code": {
+                    "$ref": "#/definitions/dto.FailureCodeDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetFailureCodesResponse": {
+            "type": "object",
+            "properties": {
+                "failure_codes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FailureCodeDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetHolidayCalendarByIDResponse": {
+            "type": "object",
+            "properties": {
+                "holiday_calendar": {
+                    "$ref": "#/definitions/dto.HolidayCalendarDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetHolidayCalendarsResponse": {
+            "type": "object",
+            "properties": {
+                "holiday_calendars": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.HolidayCalendarDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetHolidaysResponse": {
+            "type": "object",
+            "properties": {
+                "holidays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.HolidayDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetLaborEntriesResponse": {
+            "type": "object",
+            "properties": {
+                "labor_entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LaborEntryDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "total_hours": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.GetLaborHoursReportResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "group_by": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total_hours": {
+                    "type": "number"
+                },
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LaborHoursDTO"
+                    }
+                }
+            }
+        },
+        "dto.GetLowStockPartsResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PartDTO"
+                    }
+                }
+            }
+        },
+        "dto.GetMaintenanceCalendarResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MaintenanceCalendarEntryDTO"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetMaintenanceRecordByIDResponse": {
+            "type": "object",
+            "properties": {
+                "maintenance_record": {
+                    "$ref": "#/definitions/dto.MaintenanceRecordDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetMaintenanceRecordHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MaintenanceRecordStatusChangeDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetMaintenanceRecordsResponse": {
+            "type": "object",
+            "properties": {
+                "items_per_page": {
+                    "type": "integer"
+                },
+                "maintenance_records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MaintenanceRecordDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.GetMaintenanceScheduleByIDResponse": {
+            "type": "object",
+            "properties": {
+                "maintenance_schedule": {
+                    "$ref": "#/definitions/dto.MaintenanceScheduleDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetMaintenanceSchedulesResponse": {
+            "type": "object",
+            "properties": {
+                "items_per_page": {
+                    "type": "integer"
+                },
+                "maintenance_schedules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MaintenanceScheduleDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.GetMeterReadingsResponse": {
+            "type": "object",
+            "properties": {
+                "items_per_page": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "readings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MeterReadingDTO"
+                    }
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.GetNotificationsResponse": {
+            "type": "object",
+            "properties": {
+                "items_per_page": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.NotificationDTO"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                },
+                "unread_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.GetOverdueMaintenanceSchedulesResponse": {
+            "type": "object",
+            "properties": {
+                "maintenance_schedules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OverdueMaintenanceScheduleDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "total_items": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.GetPartByIDResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "part": {
+                    "$ref": "#/definitions/dto.PartDTO"
+                }
+            }
+        },
+        "dto.GetPartsResponse": {
+            "type": "object",
+            "properties": {
+                "items_per_page": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PartDTO"
+                    }
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.GetRecordApprovalsResponse": {
+            "type": "object",
+            "properties": {
+                "approvals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RecordApprovalDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetRecordChecklistResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RecordChecklistItemDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetRecordCommentsResponse": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RecordCommentDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetRecordCostsResponse": {
+            "type": "object",
+            "properties": {
+                "labor_cost": {
+                    "type": "number"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CostLineDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "parts_cost": {
+                    "type": "number"
+                },
+                "total_cost": {
+                    "type": "number"
+                },
+                "vendor_cost": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.GetRecordPartsResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "record_parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RecordPartDTO"
+                    }
+                }
+            }
+        },
+        "dto.GetReliabilityReportResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "group_by": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "metrics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReliabilityMetricsDTO"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetSLAPoliciesResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "policies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SLAPolicyDTO"
+                    }
+                }
+            }
+        },
+        "dto.GetStockLocationByIDResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "stock_location": {
+                    "$ref": "#/definitions/dto.StockLocationDTO"
+                }
+            }
+        },
+        "dto.GetStockLocationsResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "stock_locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StockLocationDTO"
+                    }
+                }
+            }
+        },
+        "dto.GetTechnicianCategoriesResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TechnicianCategoryDTO"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetUpcomingOccurrencesResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "occurrences": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.GetUserByIDResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserDTO"
+                }
+            }
+        },
+        "dto.GetUsersResponse": {
+            "type": "object",
+            "properties": {
+                "items_per_page": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UserDTO"
+                    }
+                }
+            }
+        },
+        "dto.GetVendorInvoicesResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "vendor_invoices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VendorInvoiceDTO"
+                    }
+                }
+            }
+        },
+        "dto.GetWorkRequestByIDResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "work_request": {
+                    "$ref": "#/definitions/dto.WorkRequestDTO"
+                }
+            }
+        },
+        "dto.GetWorkRequestsResponse": {
+            "type": "object",
+            "properties": {
+                "items_per_page": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                },
+                "work_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WorkRequestDTO"
+                    }
+                }
+            }
+        },
+        "dto.HolidayCalendarDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "working_days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.HolidayDTO": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ImportHolidaysResponse": {
+            "type": "object",
+            "properties": {
+                "imported": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LaborEntryDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "ended_at": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "maintenance_record_id": {
+                    "type": "string"
+                },
+                "manual": {
+                    "type": "boolean"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "running": {
+                    "type": "boolean"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LaborHoursDTO": {
+            "type": "object",
+            "properties": {
+                "entry_count": {
+                    "type": "integer"
+                },
+                "hours": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LaborTimerResponse": {
+            "type": "object",
+            "properties": {
+                "labor_entry": {
+                    "$ref": "#/definitions/dto.LaborEntryDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LogLaborEntryRequest": {
+            "type": "object",
+            "required": [
+                "duration_minutes",
+                "started_at"
+            ],
+            "properties": {
+                "duration_minutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 1
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LogLaborEntryResponse": {
+            "type": "object",
+            "properties": {
+                "labor_entry": {
+                    "$ref": "#/definitions/dto.LaborEntryDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MaintenanceCalendarEntryDTO": {
+            "type": "object",
+            "properties": {
+                "asset_id": {
+                    "type": "string"
+                },
+                "asset_name": {
+                    "type": "string"
+                },
+                "assigned_to": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "record_id": {
+                    "type": "string"
+                },
+                "record_status": {
+                    "type": "string"
+                },
+                "schedule_id": {
+                    "type": "string"
+                },
+                "schedule_type": {
+                    "type": "string"
+                },
+                "shifted_from": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MaintenanceCostsDTO": {
+            "type": "object",
+            "properties": {
+                "labor_cost": {
+                    "type": "number"
+                },
+                "labor_hours": {
+                    "type": "number"
+                },
+                "maintenance_cost": {
+                    "type": "number"
+                },
+                "parts_cost": {
+                    "type": "number"
+                },
+                "record_count": {
+                    "type": "integer"
+                },
+                "vendor_cost": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.MaintenanceRecordDTO": {
+            "type": "object",
+            "properties": {
+                "asset_id": {
+                    "type": "string"
+                },
+                "asset_name": {
+                    "type": "string"
+                },
+                "cause_code_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "maintenance_date": {
+                    "type": "string"
+                },
+                "performed_by_user_id": {
+                    "type": "string"
+                },
+                "performed_by_user_name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "problem_code_id": {
+                    "type": "string"
+                },
+                "remedy_code_id": {
+                    "type": "string"
+                },
+                "resolution_due_at": {
+                    "type": "string"
+                },
+                "responded_at": {
+                    "type": "string"
+                },
+                "response_due_at": {
+                    "type": "string"
+                },
+                "schedule_id": {
+                    "type": "string"
+                },
+                "sla_breached": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "work_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MaintenanceRecordStatusChangeDTO": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string"
+                },
+                "changed_by_user_id": {
+                    "type": "string"
+                },
+                "changed_by_user_name": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MaintenanceScheduleDTO": {
+            "type": "object",
+            "properties": {
+                "advance_from": {
+                    "type": "string"
+                },
+                "asset_id": {
+                    "type": "string"
+                },
+                "asset_name": {
+                    "type": "string"
+                },
+                "assigned_to": {
+                    "type": "string"
+                },
+                "closed_day_policy": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "holiday_calendar_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "interval_days": {
+                    "type": "integer"
+                },
+                "meter_id": {
+                    "type": "string"
+                },
+                "meter_interval": {
+                    "type": "number"
+                },
+                "meter_threshold": {
+                    "type": "number"
+                },
+                "next_maintenance_date": {
+                    "type": "string"
+                },
+                "paused": {
+                    "type": "boolean"
+                },
+                "recurrence_rule": {
+                    "type": "string"
+                },
+                "resume_policy": {
+                    "type": "string"
+                },
+                "schedule_type": {
+                    "type": "string"
+                },
+                "scheduled_by": {
+                    "type": "string"
+                },
+                "suspend_reason": {
+                    "type": "string"
+                },
+                "suspended_at": {
+                    "type": "string"
+                },
+                "suspended_by": {
+                    "type": "string"
+                },
+                "suspended_until": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MarkNotificationReadResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
                     "type": "string"
-                },
-                "category_id": {
+                }
+            }
+        },
+        "dto.MentionDTO": {
+            "type": "object",
+            "properties": {
+                "email": {
                     "type": "string"
                 },
-                "category_name": {
+                "user_id": {
                     "type": "string"
                 },
-                "condition": {
+                "user_name": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.MergeWorkRequestRequest": {
+            "type": "object",
+            "required": [
+                "target_id"
+            ],
+            "properties": {
+                "feedback": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
+                "target_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MeterReadingDTO": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "last_maintenance_date": {
+                "meter_id": {
                     "type": "string"
                 },
-                "location": {
+                "read_at": {
                     "type": "string"
                 },
-                "name": {
+                "recorded_by": {
                     "type": "string"
                 },
-                "purchase_date": {
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.NotificationDTO": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
                     "type": "string"
                 },
-                "status": {
+                "actor_name": {
                     "type": "string"
                 },
-                "updated_at": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "read": {
+                    "type": "boolean"
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "resource_id": {
+                    "type": "string"
+                },
+                "resource_type": {
+                    "type": "string"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "dto.CreateAssetCategoryRequest": {
+        "dto.OverdueMaintenanceScheduleDTO": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "name": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2
+                "asset_id": {
+                    "type": "string"
+                },
+                "asset_name": {
+                    "type": "string"
+                },
+                "asset_status": {
+                    "type": "string"
+                },
+                "assigned_to": {
+                    "type": "string"
+                },
+                "assigned_to_name": {
+                    "type": "string"
+                },
+                "days_overdue": {
+                    "type": "integer"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "next_maintenance_date": {
+                    "type": "string"
+                },
+                "schedule_id": {
+                    "type": "string"
+                },
+                "schedule_type": {
+                    "type": "string"
                 }
             }
         },
-        "dto.CreateAssetCategoryResponse": {
+        "dto.PartDTO": {
             "type": "object",
             "properties": {
-                "message": {
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "low_stock": {
+                    "type": "boolean"
+                },
+                "low_stock_threshold": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sku": {
+                    "type": "string"
+                },
+                "stocks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PartStockDTO"
+                    }
+                },
+                "total_quantity": {
+                    "type": "number"
+                },
+                "unit": {
+                    "type": "string"
+                },
+                "unit_cost": {
+                    "type": "number"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.CreateAssetRequest": {
+        "dto.PartStockDTO": {
             "type": "object",
-            "required": [
-                "added_by",
-                "category_id",
-                "name",
-                "status"
-            ],
             "properties": {
-                "added_by": {
+                "location_id": {
                     "type": "string"
                 },
-                "category_id": {
+                "location_name": {
                     "type": "string"
                 },
-                "condition": {
-                    "type": "string",
-                    "maxLength": 50
+                "quantity": {
+                    "type": "number"
                 },
-                "last_maintenance_date": {
+                "updated_at": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.PreviewRecurrenceRuleRequest": {
+            "type": "object",
+            "required": [
+                "recurrence_rule",
+                "start"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
                 },
-                "location": {
+                "recurrence_rule": {
                     "type": "string",
-                    "maxLength": 100
+                    "maxLength": 255
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RebalanceMaintenanceRecordsResponse": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
                 },
-                "purchase_date": {
+                "message": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string",
-                    "enum": [
-                        "ready",
-                        "under_maintenance",
-                        "need_maintenance"
-                    ]
+                "reassigned": {
+                    "type": "integer"
+                },
+                "technicians": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TechnicianLoadDTO"
+                    }
                 }
             }
         },
-        "dto.CreateAssetResponse": {
+        "dto.RecordApprovalDTO": {
             "type": "object",
             "properties": {
-                "message": {
+                "decided_at": {
+                    "type": "string"
+                },
+                "decided_by": {
+                    "type": "string"
+                },
+                "decider_name": {
+                    "type": "string"
+                },
+                "decision": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
                     "type": "string"
                 }
             }
         },
-        "dto.CreateMaintenanceRecordRequest": {
+        "dto.RecordChecklistItemDTO": {
             "type": "object",
-            "required": [
-                "asset_id",
-                "description",
-                "maintenance_date",
-                "status"
-            ],
             "properties": {
-                "asset_id": {
+                "completed": {
+                    "type": "boolean"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "completed_by_user_id": {
+                    "type": "string"
+                },
+                "completed_by_user_name": {
                     "type": "string"
                 },
                 "description": {
-                    "type": "string",
-                    "maxLength": 500,
-                    "minLength": 5
+                    "type": "string"
                 },
-                "maintenance_date": {
+                "id": {
                     "type": "string"
                 },
-                "performed_by": {
+                "measured_value": {
+                    "type": "number"
+                },
+                "note": {
                     "type": "string"
                 },
-                "schedule_id": {
+                "position": {
+                    "type": "integer"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "title": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string",
-                    "enum": [
-                        "pending",
-                        "in_progress",
-                        "on_hold",
-                        "finished",
-                        "failed",
-                        "cancelled"
-                    ]
+                "unit": {
+                    "type": "string"
                 }
             }
         },
-        "dto.CreateMaintenanceRecordResponse": {
+        "dto.RecordCommentDTO": {
             "type": "object",
             "properties": {
-                "message": {
+                "author_id": {
+                    "type": "string"
+                },
+                "author_name": {
+                    "type": "string"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "edited": {
+                    "type": "boolean"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "maintenance_record_id": {
                     "type": "string"
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MentionDTO"
+                    }
                 }
             }
         },
-        "dto.CreateMaintenanceScheduleRequest": {
+        "dto.RecordPartDTO": {
             "type": "object",
-            "required": [
-                "asset_id",
-                "next_maintenance_date",
-                "schedule_type"
-            ],
             "properties": {
-                "asset_id": {
+                "added_by_user_id": {
                     "type": "string"
                 },
-                "assigned_to": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deducted": {
+                    "type": "boolean"
+                },
+                "deducted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location_id": {
+                    "type": "string"
+                },
+                "location_name": {
+                    "type": "string"
+                },
+                "part_id": {
                     "type": "string"
                 },
-                "interval_days": {
-                    "type": "integer"
+                "part_name": {
+                    "type": "string"
                 },
-                "next_maintenance_date": {
+                "part_sku": {
                     "type": "string"
                 },
-                "schedule_type": {
-                    "type": "string",
-                    "enum": [
-                        "periodic",
-                        "conditional"
-                    ]
+                "quantity": {
+                    "type": "number"
                 },
-                "scheduled_by": {
+                "unit": {
                     "type": "string"
+                },
+                "unit_cost": {
+                    "type": "number"
                 }
             }
         },
-        "dto.CreateMaintenanceScheduleResponse": {
+        "dto.RejectMaintenanceRecordRequest": {
             "type": "object",
+            "required": [
+                "reason"
+            ],
             "properties": {
-                "message": {
-                    "type": "string"
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
                 }
             }
         },
-        "dto.CreateUserRequest": {
+        "dto.RejectWorkRequestRequest": {
             "type": "object",
             "required": [
-                "email",
-                "name",
-                "password",
-                "role"
+                "feedback"
             ],
             "properties": {
-                "email": {
+                "feedback": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "dto.ReliabilityMetricsDTO": {
+            "type": "object",
+            "properties": {
+                "asset_count": {
+                    "type": "integer"
+                },
+                "failure_count": {
+                    "type": "integer"
+                },
+                "id": {
                     "type": "string"
                 },
+                "mtbf_hours": {
+                    "type": "number"
+                },
+                "mttr_hours": {
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 8
+                "operating_hours": {
+                    "type": "number"
                 },
-                "role": {
-                    "type": "string",
-                    "enum": [
-                        "super_user",
-                        "admin",
-                        "technician",
-                        "manager"
-                    ]
+                "repair_count": {
+                    "type": "integer"
+                },
+                "repair_hours": {
+                    "type": "number"
                 }
             }
         },
-        "dto.CreateUserResponse": {
+        "dto.RemoveRecordPartResponse": {
             "type": "object",
             "properties": {
                 "message": {
@@ -1151,23 +6954,31 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.DeleteAssetCategoryResponse": {
+        "dto.ResumeMaintenanceScheduleRequest": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "policy": {
+                    "type": "string",
+                    "enum": [
+                        "skip_missed",
+                        "restart_from_resume",
+                        "keep"
+                    ]
                 }
             }
         },
-        "dto.DeleteAssetResponse": {
+        "dto.ResumeMaintenanceScheduleResponse": {
             "type": "object",
             "properties": {
                 "message": {
                     "type": "string"
+                },
+                "next_maintenance_date": {
+                    "type": "string"
                 }
             }
         },
-        "dto.DeleteMaintenanceRecordResponse": {
+        "dto.RevokeCalendarFeedTokenResponse": {
             "type": "object",
             "properties": {
                 "message": {
@@ -1175,284 +6986,253 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.DeleteMaintenanceScheduleResponse": {
+        "dto.SLAPolicyDTO": {
             "type": "object",
             "properties": {
-                "message": {
+                "priority": {
                     "type": "string"
+                },
+                "resolution_minutes": {
+                    "type": "integer"
+                },
+                "response_minutes": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.DeleteUserResponse": {
+        "dto.SLAPolicyResponse": {
             "type": "object",
             "properties": {
                 "message": {
                     "type": "string"
+                },
+                "policy": {
+                    "$ref": "#/definitions/dto.SLAPolicyDTO"
                 }
             }
         },
-        "dto.GetAssetByIDResponse": {
+        "dto.SetCategoryApprovalRuleRequest": {
             "type": "object",
             "properties": {
-                "asset": {
-                    "$ref": "#/definitions/dto.AssetDTO"
+                "requires_approval": {
+                    "type": "boolean"
                 },
-                "message": {
-                    "type": "string"
+                "unscheduled_only": {
+                    "type": "boolean"
                 }
             }
         },
-        "dto.GetAssetCategoriesResponse": {
+        "dto.SetChecklistRequest": {
             "type": "object",
             "properties": {
-                "asset_categories": {
+                "items": {
                     "type": "array",
+                    "maxItems": 100,
                     "items": {
-                        "$ref": "#/definitions/dto.AssetCategoryDTO"
+                        "$ref": "#/definitions/dto.ChecklistTemplateItemRequest"
                     }
-                },
-                "message": {
-                    "type": "string"
                 }
             }
         },
-        "dto.GetAssetCategoryByIDResponse": {
+        "dto.SetPartStockRequest": {
             "type": "object",
+            "required": [
+                "quantity"
+            ],
             "properties": {
-                "asset_category": {
-                    "$ref": "#/definitions/dto.AssetCategoryDTO"
-                },
-                "message": {
-                    "type": "string"
+                "quantity": {
+                    "type": "number",
+                    "minimum": 0
                 }
             }
         },
-        "dto.GetAssetsResponse": {
+        "dto.SetPartStockResponse": {
             "type": "object",
             "properties": {
-                "assets": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.AssetDTO"
-                    }
-                },
-                "items_per_page": {
-                    "type": "integer"
-                },
                 "message": {
                     "type": "string"
                 },
-                "page": {
-                    "type": "integer"
-                },
-                "total_items": {
-                    "type": "integer"
-                },
-                "total_pages": {
-                    "type": "integer"
+                "part": {
+                    "$ref": "#/definitions/dto.PartDTO"
                 }
             }
         },
-        "dto.GetMaintenanceRecordByIDResponse": {
+        "dto.SetRecordFailureCodesRequest": {
             "type": "object",
             "properties": {
-                "maintenance_record": {
-                    "$ref": "#/definitions/dto.MaintenanceRecordDTO"
+                "cause_code_id": {
+                    "type": "string"
                 },
-                "message": {
+                "problem_code_id": {
+                    "type": "string"
+                },
+                "remedy_code_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.GetMaintenanceRecordsResponse": {
+        "dto.SetRecordFailureCodesResponse": {
             "type": "object",
             "properties": {
-                "items_per_page": {
-                    "type": "integer"
-                },
-                "maintenance_records": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.MaintenanceRecordDTO"
-                    }
+                "cause_code_id": {
+                    "type": "string"
                 },
                 "message": {
                     "type": "string"
                 },
-                "page": {
-                    "type": "integer"
-                },
-                "total_items": {
-                    "type": "integer"
+                "problem_code_id": {
+                    "type": "string"
                 },
-                "total_pages": {
-                    "type": "integer"
+                "remedy_code_id": {
+                    "type": "string"
                 }
             }
         },
-        "dto.GetMaintenanceScheduleByIDResponse": {
+        "dto.SetSLAPolicyRequest": {
             "type": "object",
+            "required": [
+                "resolution_minutes",
+                "response_minutes"
+            ],
             "properties": {
-                "maintenance_schedule": {
-                    "$ref": "#/definitions/dto.MaintenanceScheduleDTO"
+                "resolution_minutes": {
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "message": {
-                    "type": "string"
+                "response_minutes": {
+                    "type": "integer",
+                    "minimum": 1
                 }
             }
         },
-        "dto.GetMaintenanceSchedulesResponse": {
+        "dto.SetTechnicianCategoriesRequest": {
             "type": "object",
+            "required": [
+                "category_ids"
+            ],
             "properties": {
-                "items_per_page": {
-                    "type": "integer"
-                },
-                "maintenance_schedules": {
+                "category_ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.MaintenanceScheduleDTO"
+                        "type": "string"
                     }
-                },
-                "message": {
-                    "type": "string"
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "total_items": {
-                    "type": "integer"
-                },
-                "total_pages": {
-                    "type": "integer"
                 }
             }
         },
-        "dto.GetUserByIDResponse": {
+        "dto.StartLaborTimerRequest": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
-                },
-                "user": {
-                    "$ref": "#/definitions/dto.UserDTO"
+                "note": {
+                    "type": "string",
+                    "maxLength": 1000
                 }
             }
         },
-        "dto.GetUsersResponse": {
+        "dto.StockLocationDTO": {
             "type": "object",
             "properties": {
-                "items_per_page": {
-                    "type": "integer"
-                },
-                "message": {
+                "created_at": {
                     "type": "string"
                 },
-                "page": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "total_items": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
-                "total_pages": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "users": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.UserDTO"
-                    }
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "dto.LoginRequest": {
+        "dto.SuspendMaintenanceScheduleRequest": {
             "type": "object",
+            "required": [
+                "reason"
+            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "password": {
+                "resume_policy": {
+                    "type": "string",
+                    "enum": [
+                        "skip_missed",
+                        "restart_from_resume",
+                        "keep"
+                    ]
+                },
+                "suspended_until": {
                     "type": "string"
                 }
             }
         },
-        "dto.LoginResponse": {
+        "dto.SuspendMaintenanceScheduleResponse": {
             "type": "object",
             "properties": {
-                "token": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "dto.MaintenanceRecordDTO": {
+        "dto.TechnicianCategoryDTO": {
             "type": "object",
             "properties": {
-                "asset_id": {
-                    "type": "string"
-                },
-                "asset_name": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "maintenance_date": {
-                    "type": "string"
-                },
-                "performed_by_user_id": {
-                    "type": "string"
-                },
-                "performed_by_user_name": {
-                    "type": "string"
-                },
-                "schedule_id": {
-                    "type": "string"
-                },
-                "status": {
+                "category_id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "category_name": {
                     "type": "string"
                 }
             }
         },
-        "dto.MaintenanceScheduleDTO": {
+        "dto.TechnicianLoadDTO": {
             "type": "object",
             "properties": {
-                "asset_id": {
-                    "type": "string"
-                },
-                "asset_name": {
-                    "type": "string"
+                "after": {
+                    "type": "integer"
                 },
-                "assigned_to": {
-                    "type": "string"
+                "before": {
+                    "type": "integer"
                 },
-                "created_at": {
+                "name": {
                     "type": "string"
                 },
-                "id": {
+                "user_id": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.TriageWorkRequestRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 5
                 },
-                "interval_days": {
-                    "type": "integer"
-                },
-                "next_maintenance_date": {
-                    "type": "string"
+                "feedback": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "schedule_type": {
+                "maintenance_date": {
                     "type": "string"
                 },
-                "scheduled_by": {
+                "performed_by": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string"
+                "priority": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ]
                 }
             }
         },
@@ -1502,6 +7282,10 @@ const docTemplate = `{
                     "maxLength": 100,
                     "minLength": 2
                 },
+                "purchase_cost": {
+                    "type": "number",
+                    "minimum": 0
+                },
                 "purchase_date": {
                     "type": "string"
                 },
@@ -1529,6 +7313,10 @@ const docTemplate = `{
                 "status"
             ],
             "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                },
                 "status": {
                     "type": "string",
                     "enum": [
@@ -1539,6 +7327,64 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UpdateFailureCodeRequest": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.UpdateFailureCodeResponse": {
+            "type": "object",
+            "properties": {
+                "failure_code": {
+                    "$ref": "#/definitions/dto.FailureCodeDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateHolidayCalendarRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "working_days": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.UpdateHolidayCalendarResponse": {
+            "type": "object",
+            "properties": {
+                "holiday_calendar": {
+                    "$ref": "#/definitions/dto.HolidayCalendarDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.UpdateMaintenanceRecordRequest": {
             "type": "object",
             "properties": {
@@ -1556,6 +7402,15 @@ const docTemplate = `{
                 "performed_by": {
                     "type": "string"
                 },
+                "priority": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ]
+                },
                 "schedule_id": {
                     "type": "string"
                 },
@@ -1586,6 +7441,10 @@ const docTemplate = `{
                 "status"
             ],
             "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
                 "status": {
                     "type": "string",
                     "enum": [
@@ -1602,15 +7461,48 @@ const docTemplate = `{
         "dto.UpdateMaintenanceScheduleRequest": {
             "type": "object",
             "properties": {
+                "advance_from": {
+                    "type": "string",
+                    "enum": [
+                        "due_date",
+                        "completion_date"
+                    ]
+                },
                 "assigned_to": {
                     "type": "string"
                 },
+                "closed_day_policy": {
+                    "type": "string",
+                    "enum": [
+                        "shift_forward",
+                        "shift_backward",
+                        "keep"
+                    ]
+                },
+                "holiday_calendar_id": {
+                    "type": "string"
+                },
                 "interval_days": {
-                    "type": "integer"
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "meter_id": {
+                    "type": "string"
+                },
+                "meter_interval": {
+                    "type": "number"
+                },
+                "meter_threshold": {
+                    "type": "number",
+                    "minimum": 0
                 },
                 "next_maintenance_date": {
                     "type": "string"
                 },
+                "recurrence_rule": {
+                    "type": "string",
+                    "maxLength": 255
+                },
                 "schedule_type": {
                     "type": "string",
                     "enum": [
@@ -1631,12 +7523,137 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UpdatePartRequest": {
+            "type": "object",
+            "properties": {
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "low_stock_threshold": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "sku": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "unit": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "unit_cost": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.UpdatePartResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "part": {
+                    "$ref": "#/definitions/dto.PartDTO"
+                }
+            }
+        },
+        "dto.UpdateRecordChecklistItemRequest": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "boolean"
+                },
+                "measured_value": {
+                    "type": "number"
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "dto.UpdateRecordChecklistItemResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/dto.RecordChecklistItemDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateRecordCommentRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000
+                }
+            }
+        },
+        "dto.UpdateRecordCommentResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/dto.RecordCommentDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateStockLocationRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.UpdateStockLocationResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "stock_location": {
+                    "$ref": "#/definitions/dto.StockLocationDTO"
+                }
+            }
+        },
         "dto.UpdateUserRequest": {
             "type": "object",
             "properties": {
                 "email": {
                     "type": "string"
                 },
+                "hourly_rate": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "manager_id": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -1663,15 +7680,32 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UploadAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "attachment": {
+                    "$ref": "#/definitions/dto.AttachmentDTO"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.UserDTO": {
             "type": "object",
             "properties": {
                 "email": {
                     "type": "string"
                 },
+                "hourly_rate": {
+                    "type": "number"
+                },
                 "id": {
                     "type": "string"
                 },
+                "manager_id": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -1679,6 +7713,99 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "dto.VendorInvoiceDTO": {
+            "type": "object",
+            "properties": {
+                "added_by": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invoice_date": {
+                    "type": "string"
+                },
+                "invoice_number": {
+                    "type": "string"
+                },
+                "maintenance_record_id": {
+                    "type": "string"
+                },
+                "vendor": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.WorkRequestDTO": {
+            "type": "object",
+            "properties": {
+                "asset_id": {
+                    "type": "string"
+                },
+                "asset_name": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "feedback": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "maintenance_record_id": {
+                    "type": "string"
+                },
+                "merged_into_id": {
+                    "type": "string"
+                },
+                "requested_by": {
+                    "type": "string"
+                },
+                "requester_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "triaged_at": {
+                    "type": "string"
+                },
+                "triaged_by": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "urgency": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.WorkRequestDecisionResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "work_request": {
+                    "$ref": "#/definitions/dto.WorkRequestDTO"
+                }
+            }
         }
     }
 }`
@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+type CategoryApprovalRuleDTO struct {
+	CategoryID       string `json:"category_id"`
+	RequiresApproval bool   `json:"requires_approval"`
+	UnscheduledOnly  bool   `json:"unscheduled_only"`
+}
+
+type SetCategoryApprovalRuleRequest struct {
+	RequiresApproval bool `json:"requires_approval"`
+	UnscheduledOnly  bool `json:"unscheduled_only"`
+}
+
+type CategoryApprovalRuleResponse struct {
+	Message string                  `json:"message"`
+	Rule    CategoryApprovalRuleDTO `json:"rule"`
+}
+
+type RecordApprovalDTO struct {
+	ID          string    `json:"id"`
+	Decision    string    `json:"decision"`
+	Reason      string    `json:"reason,omitempty"`
+	DecidedBy   string    `json:"decided_by"`
+	DeciderName string    `json:"decider_name"`
+	DecidedAt   time.Time `json:"decided_at"`
+}
+
+type GetRecordApprovalsResponse struct {
+	Message   string              `json:"message"`
+	Approvals []RecordApprovalDTO `json:"approvals"`
+}
+
+type ApproveMaintenanceRecordRequest struct {
+	Comment string `json:"comment" binding:"omitempty,max=1000"`
+}
+
+type RejectMaintenanceRecordRequest struct {
+	Reason string `json:"reason" binding:"required,max=1000"`
+}
+
+type ApprovalDecisionResponse struct {
+	Message string `json:"message"`
+}
@@ -9,9 +9,12 @@ type AssetDTO struct {
 	CategoryName        string     `json:"category_name"`
 	Location            string     `json:"location"`
 	PurchaseDate        *time.Time `json:"purchase_date"`
+	PurchaseCost        *float64   `json:"purchase_cost,omitempty"`
 	LastMaintenanceDate *time.Time `json:"last_maintenance_date"`
 	Condition           string     `json:"condition"`
 	Status              string     `json:"status"`
+	StatusReason        string     `json:"status_reason,omitempty"`
+	StatusChangedAt     *time.Time `json:"status_changed_at,omitempty"`
 	AddedBy             string     `json:"added_by"`
 	CreatedAt           time.Time  `json:"created_at"`
 	UpdatedAt           time.Time  `json:"updated_at"`
@@ -22,6 +25,7 @@ type CreateAssetRequest struct {
 	CategoryID          string     `json:"category_id" binding:"required"`
 	Location            string     `json:"location" binding:"omitempty,max=100"`
 	PurchaseDate        *time.Time `json:"purchase_date" binding:"omitempty"`
+	PurchaseCost        *float64   `json:"purchase_cost" binding:"omitempty,min=0"`
 	LastMaintenanceDate *time.Time `json:"last_maintenance_date" binding:"omitempty"`
 	Condition           string     `json:"condition" binding:"omitempty,max=50"`
 	Status              string     `json:"status" binding:"required,oneof=ready under_maintenance need_maintenance"`
@@ -61,6 +65,7 @@ type UpdateAssetRequest struct {
 	CategoryID          string     `json:"category_id" binding:"omitempty"`
 	Location            string     `json:"location" binding:"omitempty,max=100"`
 	PurchaseDate        *time.Time `json:"purchase_date" binding:"omitempty"`
+	PurchaseCost        *float64   `json:"purchase_cost" binding:"omitempty,min=0"`
 	LastMaintenanceDate *time.Time `json:"last_maintenance_date" binding:"omitempty"`
 	Condition           string     `json:"condition" binding:"omitempty,max=50"`
 	Status              string     `json:"status" binding:"omitempty,oneof=ready under_maintenance need_maintenance"`
@@ -69,6 +74,7 @@ type UpdateAssetRequest struct {
 
 type UpdateAssetStatusRequest struct {
 	Status string `json:"status" binding:"required,oneof=ready under_maintenance need_maintenance"`
+	Reason string `json:"reason" binding:"omitempty,max=255"`
 }
 
 type UpdateAssetResponse struct {
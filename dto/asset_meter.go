@@ -0,0 +1,65 @@
+package dto
+
+import "time"
+
+type AssetMeterDTO struct {
+	ID            string     `json:"id"`
+	AssetID       string     `json:"asset_id"`
+	MeterType     string     `json:"meter_type"`
+	Name          string     `json:"name"`
+	Unit          string     `json:"unit"`
+	CurrentValue  float64    `json:"current_value"`
+	LastReadingAt *time.Time `json:"last_reading_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+type CreateAssetMeterRequest struct {
+	MeterType    string  `json:"meter_type" binding:"required,oneof=run_hours odometer cycle_count"`
+	Name         string  `json:"name" binding:"required,min=2,max=100"`
+	Unit         string  `json:"unit" binding:"omitempty,max=20"`
+	InitialValue float64 `json:"initial_value" binding:"omitempty,gte=0"`
+}
+
+type CreateAssetMeterResponse struct {
+	Message string        `json:"message"`
+	Meter   AssetMeterDTO `json:"meter"`
+}
+
+type GetAssetMetersResponse struct {
+	Message string          `json:"message"`
+	Meters  []AssetMeterDTO `json:"meters"`
+}
+
+type MeterReadingDTO struct {
+	ID         string    `json:"id"`
+	MeterID    string    `json:"meter_id"`
+	Value      float64   `json:"value"`
+	ReadAt     time.Time `json:"read_at"`
+	RecordedBy string    `json:"recorded_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type CreateMeterReadingRequest struct {
+	Value  float64    `json:"value" binding:"gte=0"`
+	ReadAt *time.Time `json:"read_at"`
+}
+
+type CreateMeterReadingResponse struct {
+	Message          string `json:"message"`
+	TriggeredRecords int    `json:"triggered_records"`
+}
+
+type GetMeterReadingsRequest struct {
+	Page         int `form:"page,default=1"`
+	ItemsPerPage int `form:"items_per_page,default=10"`
+}
+
+type GetMeterReadingsResponse struct {
+	Message      string            `json:"message"`
+	Readings     []MeterReadingDTO `json:"readings"`
+	TotalItems   int               `json:"total_items"`
+	Page         int               `json:"page"`
+	ItemsPerPage int               `json:"items_per_page"`
+	TotalPages   int               `json:"total_pages"`
+}
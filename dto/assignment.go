@@ -0,0 +1,33 @@
+package dto
+
+type TechnicianCategoryDTO struct {
+	CategoryID   string `json:"category_id"`
+	CategoryName string `json:"category_name"`
+}
+
+type GetTechnicianCategoriesResponse struct {
+	Message    string                  `json:"message"`
+	Categories []TechnicianCategoryDTO `json:"categories"`
+}
+
+type SetTechnicianCategoriesRequest struct {
+	CategoryIDs []string `json:"category_ids" binding:"omitempty,dive,required"`
+}
+
+type RebalanceMaintenanceRecordsRequest struct {
+	DryRun bool `form:"dry_run"`
+}
+
+type TechnicianLoadDTO struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+	Before int    `json:"before"`
+	After  int    `json:"after"`
+}
+
+type RebalanceMaintenanceRecordsResponse struct {
+	Message     string              `json:"message"`
+	DryRun      bool                `json:"dry_run"`
+	Reassigned  int                 `json:"reassigned"`
+	Technicians []TechnicianLoadDTO `json:"technicians"`
+}
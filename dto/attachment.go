@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+type AttachmentDTO struct {
+	ID             string    `json:"id"`
+	OwnerType      string    `json:"owner_type"`
+	OwnerID        string    `json:"owner_id"`
+	Kind           string    `json:"kind"`
+	FileName       string    `json:"file_name"`
+	ContentType    string    `json:"content_type"`
+	Size           int64     `json:"size"`
+	DownloadURL    string    `json:"download_url"`
+	ThumbnailURL   *string   `json:"thumbnail_url,omitempty"`
+	UploadedBy     string    `json:"uploaded_by_user_id"`
+	UploadedByName string    `json:"uploaded_by_user_name,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type UploadAttachmentRequest struct {
+	Kind string `form:"kind" binding:"omitempty,oneof=photo_before photo_after invoice manual signed_form other"`
+}
+
+type UploadAttachmentResponse struct {
+	Message    string        `json:"message"`
+	Attachment AttachmentDTO `json:"attachment"`
+}
+
+type GetAttachmentsResponse struct {
+	Message     string          `json:"message"`
+	Attachments []AttachmentDTO `json:"attachments"`
+}
+
+type DeleteAttachmentResponse struct {
+	Message string `json:"message"`
+}
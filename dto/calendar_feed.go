@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+type CalendarFeedTokenDTO struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CreateCalendarFeedTokenRequest struct {
+	Name string `json:"name" binding:"omitempty,max=100"`
+}
+
+type CreateCalendarFeedTokenResponse struct {
+	Message string               `json:"message"`
+	Token   CalendarFeedTokenDTO `json:"token"`
+	FeedURL string               `json:"feed_url"`
+}
+
+type GetCalendarFeedTokensResponse struct {
+	Message string                 `json:"message"`
+	Tokens  []CalendarFeedTokenDTO `json:"tokens"`
+}
+
+type RevokeCalendarFeedTokenResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,59 @@
+package dto
+
+import "time"
+
+type ChecklistTemplateItemDTO struct {
+	ID          string `json:"id"`
+	Position    int    `json:"position"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required"`
+	Unit        string `json:"unit,omitempty"`
+}
+
+type ChecklistTemplateItemRequest struct {
+	Title       string `json:"title" binding:"required,max=200"`
+	Description string `json:"description,omitempty" binding:"omitempty,max=2000"`
+	Required    *bool  `json:"required,omitempty"`
+	Unit        string `json:"unit,omitempty" binding:"omitempty,max=20"`
+}
+
+type SetChecklistRequest struct {
+	Items []ChecklistTemplateItemRequest `json:"items" binding:"omitempty,max=100,dive"`
+}
+
+type GetChecklistResponse struct {
+	Message string                     `json:"message"`
+	Items   []ChecklistTemplateItemDTO `json:"items"`
+}
+
+type RecordChecklistItemDTO struct {
+	ID              string     `json:"id"`
+	Position        int        `json:"position"`
+	Title           string     `json:"title"`
+	Description     string     `json:"description,omitempty"`
+	Required        bool       `json:"required"`
+	Unit            string     `json:"unit,omitempty"`
+	Completed       bool       `json:"completed"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	CompletedBy     *string    `json:"completed_by_user_id,omitempty"`
+	CompletedByName *string    `json:"completed_by_user_name,omitempty"`
+	Note            string     `json:"note,omitempty"`
+	MeasuredValue   *float64   `json:"measured_value,omitempty"`
+}
+
+type GetRecordChecklistResponse struct {
+	Message string                   `json:"message"`
+	Items   []RecordChecklistItemDTO `json:"items"`
+}
+
+type UpdateRecordChecklistItemRequest struct {
+	Completed     bool     `json:"completed"`
+	Note          string   `json:"note,omitempty" binding:"omitempty,max=1000"`
+	MeasuredValue *float64 `json:"measured_value,omitempty"`
+}
+
+type UpdateRecordChecklistItemResponse struct {
+	Message string                 `json:"message"`
+	Item    RecordChecklistItemDTO `json:"item"`
+}
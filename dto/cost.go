@@ -0,0 +1,108 @@
+package dto
+
+import "time"
+
+type CostLineDTO struct {
+	Type        string  `json:"type"`
+	ReferenceID string  `json:"reference_id"`
+	Description string  `json:"description"`
+	Quantity    float64 `json:"quantity"`
+	UnitCost    float64 `json:"unit_cost"`
+	Amount      float64 `json:"amount"`
+}
+
+type GetRecordCostsResponse struct {
+	Message    string        `json:"message"`
+	Lines      []CostLineDTO `json:"lines"`
+	LaborCost  float64       `json:"labor_cost"`
+	PartsCost  float64       `json:"parts_cost"`
+	VendorCost float64       `json:"vendor_cost"`
+	TotalCost  float64       `json:"total_cost"`
+}
+
+type VendorInvoiceDTO struct {
+	ID            string    `json:"id"`
+	RecordID      string    `json:"maintenance_record_id"`
+	Vendor        string    `json:"vendor"`
+	InvoiceNumber string    `json:"invoice_number,omitempty"`
+	InvoiceDate   time.Time `json:"invoice_date"`
+	Amount        float64   `json:"amount"`
+	Description   string    `json:"description,omitempty"`
+	AddedBy       string    `json:"added_by"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type CreateVendorInvoiceRequest struct {
+	Vendor        string  `json:"vendor" binding:"required,max=100"`
+	InvoiceNumber string  `json:"invoice_number,omitempty" binding:"omitempty,max=50"`
+	InvoiceDate   string  `json:"invoice_date" binding:"required,datetime=2006-01-02"`
+	Amount        float64 `json:"amount" binding:"required,gt=0"`
+	Description   string  `json:"description,omitempty" binding:"omitempty,max=2000"`
+}
+
+type CreateVendorInvoiceResponse struct {
+	Message       string           `json:"message"`
+	VendorInvoice VendorInvoiceDTO `json:"vendor_invoice"`
+}
+
+type GetVendorInvoicesResponse struct {
+	Message        string             `json:"message"`
+	VendorInvoices []VendorInvoiceDTO `json:"vendor_invoices"`
+}
+
+type DeleteVendorInvoiceResponse struct {
+	Message string `json:"message"`
+}
+
+type MaintenanceCostsDTO struct {
+	RecordCount     int     `json:"record_count"`
+	LaborHours      float64 `json:"labor_hours"`
+	LaborCost       float64 `json:"labor_cost"`
+	PartsCost       float64 `json:"parts_cost"`
+	VendorCost      float64 `json:"vendor_cost"`
+	MaintenanceCost float64 `json:"maintenance_cost"`
+}
+
+type GetAssetCostSummaryRequest struct {
+	From *time.Time `form:"from" time_format:"2006-01-02"`
+	To   *time.Time `form:"to" time_format:"2006-01-02"`
+}
+
+type AssetCostSummaryDTO struct {
+	AssetID      string              `json:"asset_id"`
+	AssetName    string              `json:"asset_name"`
+	CategoryID   string              `json:"category_id"`
+	PurchaseDate *time.Time          `json:"purchase_date"`
+	PurchaseCost float64             `json:"purchase_cost"`
+	Maintenance  MaintenanceCostsDTO `json:"maintenance"`
+	TotalCost    float64             `json:"total_cost"`
+}
+
+type GetAssetCostSummaryResponse struct {
+	Message     string              `json:"message"`
+	From        *time.Time          `json:"from,omitempty"`
+	To          *time.Time          `json:"to,omitempty"`
+	CostSummary AssetCostSummaryDTO `json:"cost_summary"`
+}
+
+type GetCategoryCostRollupRequest struct {
+	From time.Time `form:"from" time_format:"2006-01-02" binding:"required"`
+	To   time.Time `form:"to" time_format:"2006-01-02" binding:"required"`
+}
+
+type CategoryCostDTO struct {
+	CategoryID      string              `json:"category_id"`
+	CategoryName    string              `json:"category_name"`
+	AssetsPurchased int                 `json:"assets_purchased"`
+	PurchaseCost    float64             `json:"purchase_cost"`
+	Maintenance     MaintenanceCostsDTO `json:"maintenance"`
+	TotalCost       float64             `json:"total_cost"`
+}
+
+type GetCategoryCostRollupResponse struct {
+	Message    string            `json:"message"`
+	From       time.Time         `json:"from"`
+	To         time.Time         `json:"to"`
+	Categories []CategoryCostDTO `json:"categories"`
+	TotalCost  float64           `json:"total_cost"`
+}
@@ -0,0 +1,70 @@
+package dto
+
+import "time"
+
+type FailureCodeDTO struct {
+	ID          string    `json:"id"`
+	Type        string    `json:"type"`
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type CreateFailureCodeRequest struct {
+	Type        string `json:"type" binding:"required,oneof=problem cause remedy"`
+	Code        string `json:"code" binding:"required,max=20"`
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description,omitempty" binding:"omitempty,max=2000"`
+}
+
+type CreateFailureCodeResponse struct {
+	Message     string         `json:"message"`
+	FailureCode FailureCodeDTO `json:"failure_code"`
+}
+
+type GetFailureCodesRequest struct {
+	Type            string `form:"type" binding:"omitempty,oneof=problem cause remedy"`
+	IncludeInactive bool   `form:"include_inactive"`
+}
+
+type GetFailureCodesResponse struct {
+	Message      string           `json:"message"`
+	FailureCodes []FailureCodeDTO `json:"failure_codes"`
+}
+
+type GetFailureCodeByIDResponse struct {
+	Message     string         `json:"message"`
+	FailureCode FailureCodeDTO `json:"failure_code"`
+}
+
+type UpdateFailureCodeRequest struct {
+	Code        string `json:"code,omitempty" binding:"omitempty,max=20"`
+	Name        string `json:"name,omitempty" binding:"omitempty,max=100"`
+	Description string `json:"description,omitempty" binding:"omitempty,max=2000"`
+	Active      *bool  `json:"active,omitempty"`
+}
+
+type UpdateFailureCodeResponse struct {
+	Message     string         `json:"message"`
+	FailureCode FailureCodeDTO `json:"failure_code"`
+}
+
+type DeleteFailureCodeResponse struct {
+	Message string `json:"message"`
+}
+
+type SetRecordFailureCodesRequest struct {
+	ProblemCodeID *string `json:"problem_code_id,omitempty"`
+	CauseCodeID   *string `json:"cause_code_id,omitempty"`
+	RemedyCodeID  *string `json:"remedy_code_id,omitempty"`
+}
+
+type SetRecordFailureCodesResponse struct {
+	Message       string  `json:"message"`
+	ProblemCodeID *string `json:"problem_code_id,omitempty"`
+	CauseCodeID   *string `json:"cause_code_id,omitempty"`
+	RemedyCodeID  *string `json:"remedy_code_id,omitempty"`
+}
@@ -0,0 +1,80 @@
+package dto
+
+import "time"
+
+type HolidayCalendarDTO struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	WorkingDays []string  `json:"working_days"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type HolidayDTO struct {
+	ID   string    `json:"id"`
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+}
+
+type CreateHolidayCalendarRequest struct {
+	Name        string   `json:"name" binding:"required,max=100"`
+	WorkingDays []string `json:"working_days" binding:"omitempty,dive,oneof=sun mon tue wed thu fri sat"`
+}
+
+type CreateHolidayCalendarResponse struct {
+	Message         string             `json:"message"`
+	HolidayCalendar HolidayCalendarDTO `json:"holiday_calendar"`
+}
+
+type GetHolidayCalendarsResponse struct {
+	Message          string               `json:"message"`
+	HolidayCalendars []HolidayCalendarDTO `json:"holiday_calendars"`
+}
+
+type GetHolidayCalendarByIDResponse struct {
+	Message         string             `json:"message"`
+	HolidayCalendar HolidayCalendarDTO `json:"holiday_calendar"`
+}
+
+type UpdateHolidayCalendarRequest struct {
+	Name        string   `json:"name" binding:"omitempty,max=100"`
+	WorkingDays []string `json:"working_days" binding:"omitempty,min=1,dive,oneof=sun mon tue wed thu fri sat"`
+}
+
+type UpdateHolidayCalendarResponse struct {
+	Message         string             `json:"message"`
+	HolidayCalendar HolidayCalendarDTO `json:"holiday_calendar"`
+}
+
+type DeleteHolidayCalendarResponse struct {
+	Message string `json:"message"`
+}
+
+type GetHolidaysRequest struct {
+	From *time.Time `form:"from" time_format:"2006-01-02"`
+	To   *time.Time `form:"to" time_format:"2006-01-02"`
+}
+
+type GetHolidaysResponse struct {
+	Message  string       `json:"message"`
+	Holidays []HolidayDTO `json:"holidays"`
+}
+
+type CreateHolidayRequest struct {
+	Date string `json:"date" binding:"required,datetime=2006-01-02"`
+	Name string `json:"name" binding:"omitempty,max=255"`
+}
+
+type CreateHolidayResponse struct {
+	Message string     `json:"message"`
+	Holiday HolidayDTO `json:"holiday"`
+}
+
+type DeleteHolidayResponse struct {
+	Message string `json:"message"`
+}
+
+type ImportHolidaysResponse struct {
+	Message  string `json:"message"`
+	Imported int    `json:"imported"`
+}
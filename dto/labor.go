@@ -0,0 +1,70 @@
+package dto
+
+import "time"
+
+type LaborEntryDTO struct {
+	ID        string     `json:"id"`
+	RecordID  string     `json:"maintenance_record_id"`
+	UserID    string     `json:"user_id"`
+	UserName  string     `json:"user_name"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	Running   bool       `json:"running"`
+	Hours     float64    `json:"hours"`
+	Manual    bool       `json:"manual"`
+	Note      string     `json:"note,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type StartLaborTimerRequest struct {
+	Note string `json:"note,omitempty" binding:"omitempty,max=1000"`
+}
+
+type LaborTimerResponse struct {
+	Message    string        `json:"message"`
+	LaborEntry LaborEntryDTO `json:"labor_entry"`
+}
+
+type LogLaborEntryRequest struct {
+	UserID          string    `json:"user_id,omitempty"`
+	StartedAt       time.Time `json:"started_at" binding:"required"`
+	DurationMinutes int       `json:"duration_minutes" binding:"required,min=1,max=1440"`
+	Note            string    `json:"note,omitempty" binding:"omitempty,max=1000"`
+}
+
+type LogLaborEntryResponse struct {
+	Message    string        `json:"message"`
+	LaborEntry LaborEntryDTO `json:"labor_entry"`
+}
+
+type GetLaborEntriesResponse struct {
+	Message      string          `json:"message"`
+	LaborEntries []LaborEntryDTO `json:"labor_entries"`
+	TotalHours   float64         `json:"total_hours"`
+}
+
+type DeleteLaborEntryResponse struct {
+	Message string `json:"message"`
+}
+
+type GetLaborHoursReportRequest struct {
+	GroupBy string    `form:"group_by" binding:"required,oneof=record asset technician"`
+	From    time.Time `form:"from" time_format:"2006-01-02" binding:"required"`
+	To      time.Time `form:"to" time_format:"2006-01-02" binding:"required"`
+}
+
+type LaborHoursDTO struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Hours      float64 `json:"hours"`
+	EntryCount int     `json:"entry_count"`
+}
+
+type GetLaborHoursReportResponse struct {
+	Message    string          `json:"message"`
+	GroupBy    string          `json:"group_by"`
+	From       time.Time       `json:"from"`
+	To         time.Time       `json:"to"`
+	Totals     []LaborHoursDTO `json:"totals"`
+	TotalHours float64         `json:"total_hours"`
+}
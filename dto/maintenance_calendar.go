@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+type GetMaintenanceCalendarRequest struct {
+	From       time.Time `form:"from" time_format:"2006-01-02" binding:"required"`
+	To         time.Time `form:"to" time_format:"2006-01-02" binding:"required"`
+	AssignedTo string    `form:"assigned_to"`
+	AssetID    string    `form:"asset_id"`
+}
+
+type MaintenanceCalendarEntryDTO struct {
+	Date         time.Time  `json:"date"`
+	ShiftedFrom  *time.Time `json:"shifted_from,omitempty"`
+	State        string     `json:"state"`
+	AssetID      string     `json:"asset_id"`
+	AssetName    string     `json:"asset_name"`
+	AssignedTo   *string    `json:"assigned_to,omitempty"`
+	ScheduleID   *string    `json:"schedule_id,omitempty"`
+	ScheduleType *string    `json:"schedule_type,omitempty"`
+	RecordID     *string    `json:"record_id,omitempty"`
+	RecordStatus *string    `json:"record_status,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+}
+
+type GetMaintenanceCalendarResponse struct {
+	Message string                        `json:"message"`
+	From    time.Time                     `json:"from"`
+	To      time.Time                     `json:"to"`
+	Entries []MaintenanceCalendarEntryDTO `json:"entries"`
+}
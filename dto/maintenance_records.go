@@ -3,17 +3,27 @@ package dto
 import "time"
 
 type MaintenanceRecordDTO struct {
-	ID              string    `json:"id"`
-	AssetID         string    `json:"asset_id"`
-	AssetName       string    `json:"asset_name"`
-	ScheduleID      *string   `json:"schedule_id,omitempty"`
-	PerformedBy     *string   `json:"performed_by_user_id,omitempty"`
-	PerformedByName *string   `json:"performed_by_user_name,omitempty"`
-	Description     string    `json:"description"`
-	Status          string    `json:"status"`
-	MaintenanceDate time.Time `json:"maintenance_date"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
+	ID              string     `json:"id"`
+	AssetID         string     `json:"asset_id"`
+	AssetName       string     `json:"asset_name"`
+	ScheduleID      *string    `json:"schedule_id,omitempty"`
+	PerformedBy     *string    `json:"performed_by_user_id,omitempty"`
+	PerformedByName *string    `json:"performed_by_user_name,omitempty"`
+	Description     string     `json:"description"`
+	Status          string     `json:"status"`
+	MaintenanceDate time.Time  `json:"maintenance_date"`
+	DueAt           *time.Time `json:"due_at,omitempty"`
+	ProblemCodeID   *string    `json:"problem_code_id,omitempty"`
+	CauseCodeID     *string    `json:"cause_code_id,omitempty"`
+	RemedyCodeID    *string    `json:"remedy_code_id,omitempty"`
+	WorkRequestID   *string    `json:"work_request_id,omitempty"`
+	Priority        string     `json:"priority"`
+	ResponseDueAt   *time.Time `json:"response_due_at,omitempty"`
+	ResolutionDueAt *time.Time `json:"resolution_due_at,omitempty"`
+	RespondedAt     *time.Time `json:"responded_at,omitempty"`
+	SLABreached     bool       `json:"sla_breached"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
 type CreateMaintenanceRecordRequest struct {
@@ -23,6 +33,7 @@ type CreateMaintenanceRecordRequest struct {
 	Description     string    `json:"description" binding:"required,min=5,max=500"`
 	Status          string    `json:"status" binding:"required,oneof=pending in_progress on_hold finished failed cancelled"`
 	MaintenanceDate time.Time `json:"maintenance_date" binding:"required"`
+	Priority        string    `json:"priority,omitempty" binding:"omitempty,oneof=low medium high critical"`
 }
 
 type CreateMaintenanceRecordResponse struct {
@@ -60,16 +71,33 @@ type UpdateMaintenanceRecordRequest struct {
 	Description     string    `json:"description,omitempty" binding:"omitempty,min=5,max=500"`
 	Status          string    `json:"status,omitempty" binding:"omitempty,oneof=pending in_progress on_hold finished failed cancelled"`
 	MaintenanceDate time.Time `json:"maintenance_date,omitempty"`
+	Priority        string    `json:"priority,omitempty" binding:"omitempty,oneof=low medium high critical"`
 }
 
 type UpdateMaintenanceRecordStatusRequest struct {
-	Status string `json:"status" binding:"required,oneof=pending in_progress on_hold finished failed cancelled"`
+	Status  string `json:"status" binding:"required,oneof=pending in_progress on_hold finished failed cancelled"`
+	Comment string `json:"comment,omitempty" binding:"omitempty,max=1000"`
 }
 
 type UpdateMaintenanceRecordResponse struct {
 	Message string `json:"message"`
 }
 
+type MaintenanceRecordStatusChangeDTO struct {
+	ID            string    `json:"id"`
+	FromStatus    string    `json:"from_status"`
+	ToStatus      string    `json:"to_status"`
+	Comment       string    `json:"comment,omitempty"`
+	ChangedBy     *string   `json:"changed_by_user_id,omitempty"`
+	ChangedByName *string   `json:"changed_by_user_name,omitempty"`
+	ChangedAt     time.Time `json:"changed_at"`
+}
+
+type GetMaintenanceRecordHistoryResponse struct {
+	Message string                             `json:"message"`
+	History []MaintenanceRecordStatusChangeDTO `json:"history"`
+}
+
 type DeleteMaintenanceRecordResponse struct {
 	Message string `json:"message"`
 }
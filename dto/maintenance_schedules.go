@@ -3,23 +3,43 @@ package dto
 import "time"
 
 type MaintenanceScheduleDTO struct {
-	ID                  string    `json:"id"`
-	AssetID             string    `json:"asset_id"`
-	AssetName           string    `json:"asset_name"`
-	ScheduleType        string    `json:"schedule_type"`
-	IntervalDays        *int      `json:"interval_days,omitempty"`
-	NextMaintenanceDate time.Time `json:"next_maintenance_date"`
-	ScheduledBy         string    `json:"scheduled_by"`
-	AssignedTo          string    `json:"assigned_to"`
-	CreatedAt           time.Time `json:"created_at"`
-	UpdatedAt           time.Time `json:"updated_at"`
+	ID                  string     `json:"id"`
+	AssetID             string     `json:"asset_id"`
+	AssetName           string     `json:"asset_name"`
+	ScheduleType        string     `json:"schedule_type"`
+	IntervalDays        *int       `json:"interval_days,omitempty"`
+	RecurrenceRule      *string    `json:"recurrence_rule,omitempty"`
+	MeterID             *string    `json:"meter_id,omitempty"`
+	MeterInterval       *float64   `json:"meter_interval,omitempty"`
+	MeterThreshold      *float64   `json:"meter_threshold,omitempty"`
+	AdvanceFrom         string     `json:"advance_from"`
+	NextMaintenanceDate time.Time  `json:"next_maintenance_date"`
+	HolidayCalendarID   *string    `json:"holiday_calendar_id,omitempty"`
+	ClosedDayPolicy     string     `json:"closed_day_policy"`
+	Paused              bool       `json:"paused"`
+	SuspendedAt         *time.Time `json:"suspended_at,omitempty"`
+	SuspendedUntil      *time.Time `json:"suspended_until,omitempty"`
+	SuspendedBy         *string    `json:"suspended_by,omitempty"`
+	SuspendReason       string     `json:"suspend_reason,omitempty"`
+	ResumePolicy        string     `json:"resume_policy,omitempty"`
+	ScheduledBy         string     `json:"scheduled_by"`
+	AssignedTo          string     `json:"assigned_to"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
 }
 
 type CreateMaintenanceScheduleRequest struct {
 	AssetID             string    `json:"asset_id" binding:"required"`
 	ScheduleType        string    `json:"schedule_type" binding:"required,oneof=periodic conditional"`
-	IntervalDays        *int      `json:"interval_days"`
+	IntervalDays        *int      `json:"interval_days" binding:"omitempty,min=1"`
+	RecurrenceRule      *string   `json:"recurrence_rule" binding:"omitempty,max=255,rrule"`
+	MeterID             *string   `json:"meter_id"`
+	MeterInterval       *float64  `json:"meter_interval" binding:"omitempty,gt=0"`
+	MeterThreshold      *float64  `json:"meter_threshold" binding:"omitempty,gte=0"`
+	AdvanceFrom         string    `json:"advance_from" binding:"omitempty,oneof=due_date completion_date"`
 	NextMaintenanceDate time.Time `json:"next_maintenance_date" binding:"required"`
+	HolidayCalendarID   *string   `json:"holiday_calendar_id"`
+	ClosedDayPolicy     string    `json:"closed_day_policy" binding:"omitempty,oneof=shift_forward shift_backward keep"`
 	ScheduledBy         string    `json:"scheduled_by"`
 	AssignedTo          string    `json:"assigned_to"`
 }
@@ -55,8 +75,15 @@ type GetMaintenanceSchedulesResponse struct {
 
 type UpdateMaintenanceScheduleRequest struct {
 	ScheduleType        string    `json:"schedule_type" binding:"omitempty,oneof=periodic conditional"`
-	IntervalDays        *int      `json:"interval_days"`
+	IntervalDays        *int      `json:"interval_days" binding:"omitempty,min=1"`
+	RecurrenceRule      *string   `json:"recurrence_rule" binding:"omitempty,max=255,rrule"`
+	MeterID             *string   `json:"meter_id"`
+	MeterInterval       *float64  `json:"meter_interval" binding:"omitempty,gt=0"`
+	MeterThreshold      *float64  `json:"meter_threshold" binding:"omitempty,gte=0"`
+	AdvanceFrom         string    `json:"advance_from" binding:"omitempty,oneof=due_date completion_date"`
 	NextMaintenanceDate time.Time `json:"next_maintenance_date"`
+	HolidayCalendarID   *string   `json:"holiday_calendar_id"`
+	ClosedDayPolicy     string    `json:"closed_day_policy" binding:"omitempty,oneof=shift_forward shift_backward keep"`
 	ScheduledBy         string    `json:"scheduled_by"`
 	AssignedTo          string    `json:"assigned_to"`
 }
@@ -65,6 +92,59 @@ type UpdateMaintenanceScheduleResponse struct {
 	Message string `json:"message"`
 }
 
+type SuspendMaintenanceScheduleRequest struct {
+	Reason         string     `json:"reason" binding:"required,max=255"`
+	SuspendedUntil *time.Time `json:"suspended_until"`
+	ResumePolicy   string     `json:"resume_policy" binding:"omitempty,oneof=skip_missed restart_from_resume keep"`
+}
+
+type SuspendMaintenanceScheduleResponse struct {
+	Message string `json:"message"`
+}
+
+type ResumeMaintenanceScheduleRequest struct {
+	Policy string `json:"policy" binding:"omitempty,oneof=skip_missed restart_from_resume keep"`
+}
+
+type ResumeMaintenanceScheduleResponse struct {
+	Message             string    `json:"message"`
+	NextMaintenanceDate time.Time `json:"next_maintenance_date"`
+}
+
 type DeleteMaintenanceScheduleResponse struct {
 	Message string `json:"message"`
 }
+
+type GetUpcomingOccurrencesRequest struct {
+	Count int `form:"count,default=5" binding:"min=1,max=100"`
+}
+
+type PreviewRecurrenceRuleRequest struct {
+	RecurrenceRule string    `json:"recurrence_rule" binding:"required,max=255,rrule"`
+	Start          time.Time `json:"start" binding:"required"`
+	Count          int       `json:"count" binding:"omitempty,min=1,max=100"`
+}
+
+type GetUpcomingOccurrencesResponse struct {
+	Message     string      `json:"message"`
+	Occurrences []time.Time `json:"occurrences"`
+}
+
+type OverdueMaintenanceScheduleDTO struct {
+	ScheduleID          string    `json:"schedule_id"`
+	AssetID             string    `json:"asset_id"`
+	AssetName           string    `json:"asset_name"`
+	AssetStatus         string    `json:"asset_status"`
+	ScheduleType        string    `json:"schedule_type"`
+	NextMaintenanceDate time.Time `json:"next_maintenance_date"`
+	DueDate             time.Time `json:"due_date"`
+	DaysOverdue         int       `json:"days_overdue"`
+	AssignedTo          string    `json:"assigned_to"`
+	AssignedToName      *string   `json:"assigned_to_name,omitempty"`
+}
+
+type GetOverdueMaintenanceSchedulesResponse struct {
+	Message              string                          `json:"message"`
+	MaintenanceSchedules []OverdueMaintenanceScheduleDTO `json:"maintenance_schedules"`
+	TotalItems           int                             `json:"total_items"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+type NotificationDTO struct {
+	ID           string     `json:"id"`
+	Type         string     `json:"type"`
+	Message      string     `json:"message"`
+	ResourceType string     `json:"resource_type,omitempty"`
+	ResourceID   string     `json:"resource_id,omitempty"`
+	ActorID      *string    `json:"actor_id,omitempty"`
+	ActorName    *string    `json:"actor_name,omitempty"`
+	Read         bool       `json:"read"`
+	ReadAt       *time.Time `json:"read_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type GetNotificationsRequest struct {
+	Page         int  `form:"page,default=1"`
+	ItemsPerPage int  `form:"items_per_page,default=20"`
+	UnreadOnly   bool `form:"unread_only"`
+}
+
+type GetNotificationsResponse struct {
+	Message       string            `json:"message"`
+	Notifications []NotificationDTO `json:"notifications"`
+	UnreadCount   int               `json:"unread_count"`
+	TotalItems    int               `json:"total_items"`
+	Page          int               `json:"page"`
+	ItemsPerPage  int               `json:"items_per_page"`
+	TotalPages    int               `json:"total_pages"`
+}
+
+type MarkNotificationReadResponse struct {
+	Message string `json:"message"`
+}
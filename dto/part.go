@@ -0,0 +1,133 @@
+package dto
+
+import "time"
+
+type PartStockDTO struct {
+	LocationID   string    `json:"location_id"`
+	LocationName string    `json:"location_name"`
+	Quantity     float64   `json:"quantity"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type PartDTO struct {
+	ID                string         `json:"id"`
+	SKU               string         `json:"sku"`
+	Name              string         `json:"name"`
+	Description       string         `json:"description,omitempty"`
+	Unit              string         `json:"unit"`
+	LowStockThreshold float64        `json:"low_stock_threshold"`
+	UnitCost          *float64       `json:"unit_cost,omitempty"`
+	CategoryIDs       []string       `json:"category_ids"`
+	Stocks            []PartStockDTO `json:"stocks"`
+	TotalQuantity     float64        `json:"total_quantity"`
+	LowStock          bool           `json:"low_stock"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+}
+
+type CreatePartRequest struct {
+	SKU               string   `json:"sku" binding:"required,max=50"`
+	Name              string   `json:"name" binding:"required,max=100"`
+	Description       string   `json:"description,omitempty"`
+	Unit              string   `json:"unit" binding:"required,max=20"`
+	LowStockThreshold float64  `json:"low_stock_threshold,omitempty" binding:"omitempty,min=0"`
+	UnitCost          *float64 `json:"unit_cost,omitempty" binding:"omitempty,min=0"`
+	CategoryIDs       []string `json:"category_ids,omitempty"`
+}
+
+type CreatePartResponse struct {
+	Message string  `json:"message"`
+	Part    PartDTO `json:"part"`
+}
+
+type GetPartsRequest struct {
+	Page         int    `form:"page,default=1"`
+	ItemsPerPage int    `form:"items_per_page,default=10"`
+	Search       string `form:"search,omitempty"`
+	CategoryID   string `form:"category_id,omitempty"`
+}
+
+type GetPartsResponse struct {
+	Message      string    `json:"message"`
+	Parts        []PartDTO `json:"parts"`
+	TotalItems   int       `json:"total_items"`
+	Page         int       `json:"page"`
+	ItemsPerPage int       `json:"items_per_page"`
+	TotalPages   int       `json:"total_pages"`
+}
+
+type GetPartByIDResponse struct {
+	Message string  `json:"message"`
+	Part    PartDTO `json:"part"`
+}
+
+// UpdatePartRequest leaves omitted fields unchanged; an empty category_ids
+// list makes the part fit every asset.
+type UpdatePartRequest struct {
+	SKU               string   `json:"sku,omitempty" binding:"omitempty,max=50"`
+	Name              string   `json:"name,omitempty" binding:"omitempty,max=100"`
+	Description       string   `json:"description,omitempty"`
+	Unit              string   `json:"unit,omitempty" binding:"omitempty,max=20"`
+	LowStockThreshold *float64 `json:"low_stock_threshold,omitempty" binding:"omitempty,min=0"`
+	UnitCost          *float64 `json:"unit_cost,omitempty" binding:"omitempty,min=0"`
+	CategoryIDs       []string `json:"category_ids,omitempty"`
+}
+
+type UpdatePartResponse struct {
+	Message string  `json:"message"`
+	Part    PartDTO `json:"part"`
+}
+
+type DeletePartResponse struct {
+	Message string `json:"message"`
+}
+
+type SetPartStockRequest struct {
+	Quantity *float64 `json:"quantity" binding:"required,min=0"`
+}
+
+type SetPartStockResponse struct {
+	Message string  `json:"message"`
+	Part    PartDTO `json:"part"`
+}
+
+type GetLowStockPartsResponse struct {
+	Message string    `json:"message"`
+	Parts   []PartDTO `json:"parts"`
+}
+
+type RecordPartDTO struct {
+	ID           string     `json:"id"`
+	PartID       string     `json:"part_id"`
+	PartSKU      string     `json:"part_sku"`
+	PartName     string     `json:"part_name"`
+	Unit         string     `json:"unit"`
+	LocationID   string     `json:"location_id"`
+	LocationName string     `json:"location_name"`
+	Quantity     float64    `json:"quantity"`
+	UnitCost     *float64   `json:"unit_cost,omitempty"`
+	AddedBy      string     `json:"added_by_user_id"`
+	Deducted     bool       `json:"deducted"`
+	DeductedAt   *time.Time `json:"deducted_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type AddRecordPartRequest struct {
+	PartID     string  `json:"part_id" binding:"required"`
+	LocationID string  `json:"location_id" binding:"required"`
+	Quantity   float64 `json:"quantity" binding:"required,gt=0"`
+}
+
+type AddRecordPartResponse struct {
+	Message    string        `json:"message"`
+	RecordPart RecordPartDTO `json:"record_part"`
+}
+
+type GetRecordPartsResponse struct {
+	Message     string          `json:"message"`
+	RecordParts []RecordPartDTO `json:"record_parts"`
+}
+
+type RemoveRecordPartResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,44 @@
+package dto
+
+import "time"
+
+type MentionDTO struct {
+	UserID   string `json:"user_id"`
+	UserName string `json:"user_name"`
+	Email    string `json:"email"`
+}
+
+type RecordCommentDTO struct {
+	ID         string       `json:"id"`
+	RecordID   string       `json:"maintenance_record_id"`
+	AuthorID   string       `json:"author_id"`
+	AuthorName string       `json:"author_name"`
+	Body       string       `json:"body"`
+	Mentions   []MentionDTO `json:"mentions"`
+	Edited     bool         `json:"edited"`
+	EditedAt   *time.Time   `json:"edited_at,omitempty"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type CreateRecordCommentRequest struct {
+	Body string `json:"body" binding:"required,max=5000"`
+}
+
+type CreateRecordCommentResponse struct {
+	Message string           `json:"message"`
+	Comment RecordCommentDTO `json:"comment"`
+}
+
+type GetRecordCommentsResponse struct {
+	Message  string             `json:"message"`
+	Comments []RecordCommentDTO `json:"comments"`
+}
+
+type UpdateRecordCommentRequest struct {
+	Body string `json:"body" binding:"required,max=5000"`
+}
+
+type UpdateRecordCommentResponse struct {
+	Message string           `json:"message"`
+	Comment RecordCommentDTO `json:"comment"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+type GetReliabilityReportRequest struct {
+	GroupBy    string    `form:"group_by" binding:"required,oneof=asset category"`
+	From       time.Time `form:"from" time_format:"2006-01-02" binding:"required"`
+	To         time.Time `form:"to" time_format:"2006-01-02" binding:"required"`
+	CategoryID string    `form:"category_id"`
+}
+
+type ReliabilityMetricsDTO struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	AssetCount     int      `json:"asset_count"`
+	FailureCount   int      `json:"failure_count"`
+	RepairCount    int      `json:"repair_count"`
+	OperatingHours float64  `json:"operating_hours"`
+	RepairHours    float64  `json:"repair_hours"`
+	MTBFHours      *float64 `json:"mtbf_hours"`
+	MTTRHours      *float64 `json:"mttr_hours"`
+}
+
+type GetReliabilityReportResponse struct {
+	Message string                  `json:"message"`
+	GroupBy string                  `json:"group_by"`
+	From    time.Time               `json:"from"`
+	To      time.Time               `json:"to"`
+	Metrics []ReliabilityMetricsDTO `json:"metrics"`
+}
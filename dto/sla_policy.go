@@ -0,0 +1,26 @@
+package dto
+
+type SLAPolicyDTO struct {
+	Priority          string `json:"priority"`
+	ResponseMinutes   int    `json:"response_minutes"`
+	ResolutionMinutes int    `json:"resolution_minutes"`
+}
+
+type GetSLAPoliciesResponse struct {
+	Message  string         `json:"message"`
+	Policies []SLAPolicyDTO `json:"policies"`
+}
+
+type SetSLAPolicyRequest struct {
+	ResponseMinutes   int `json:"response_minutes" binding:"required,min=1"`
+	ResolutionMinutes int `json:"resolution_minutes" binding:"required,min=1"`
+}
+
+type SLAPolicyResponse struct {
+	Message string       `json:"message"`
+	Policy  SLAPolicyDTO `json:"policy"`
+}
+
+type DeleteSLAPolicyResponse struct {
+	Message string `json:"message"`
+}
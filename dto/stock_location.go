@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+type StockLocationDTO struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type CreateStockLocationRequest struct {
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description,omitempty" binding:"omitempty,max=255"`
+}
+
+type CreateStockLocationResponse struct {
+	Message       string           `json:"message"`
+	StockLocation StockLocationDTO `json:"stock_location"`
+}
+
+type GetStockLocationsResponse struct {
+	Message        string             `json:"message"`
+	StockLocations []StockLocationDTO `json:"stock_locations"`
+}
+
+type GetStockLocationByIDResponse struct {
+	Message       string           `json:"message"`
+	StockLocation StockLocationDTO `json:"stock_location"`
+}
+
+type UpdateStockLocationRequest struct {
+	Name        string `json:"name,omitempty" binding:"omitempty,max=100"`
+	Description string `json:"description,omitempty" binding:"omitempty,max=255"`
+}
+
+type UpdateStockLocationResponse struct {
+	Message       string           `json:"message"`
+	StockLocation StockLocationDTO `json:"stock_location"`
+}
+
+type DeleteStockLocationResponse struct {
+	Message string `json:"message"`
+}
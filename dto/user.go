@@ -1,10 +1,12 @@
 package dto
 
 type UserDTO struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
-	Role  string `json:"role"`
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Email      string   `json:"email"`
+	Role       string   `json:"role"`
+	HourlyRate *float64 `json:"hourly_rate,omitempty"`
+	ManagerID  *string  `json:"manager_id,omitempty"`
 }
 
 type GetUserByIDResponse struct {
@@ -30,10 +32,12 @@ type GetUsersResponse struct {
 }
 
 type CreateUserRequest struct {
-	Name     string `json:"name" binding:"required"`
-	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=8"`
-	Role     string `json:"role" binding:"required,oneof=super_user admin technician manager"`
+	Name       string   `json:"name" binding:"required"`
+	Email      string   `json:"email" binding:"required,email"`
+	Password   string   `json:"password" binding:"required,min=8"`
+	Role       string   `json:"role" binding:"required,oneof=super_user admin technician manager"`
+	HourlyRate *float64 `json:"hourly_rate,omitempty" binding:"omitempty,min=0"`
+	ManagerID  *string  `json:"manager_id,omitempty"`
 }
 
 type CreateUserResponse struct {
@@ -41,10 +45,12 @@ type CreateUserResponse struct {
 }
 
 type UpdateUserRequest struct {
-	Name     string `json:"name,omitempty"`
-	Email    string `json:"email,omitempty" binding:"omitempty,email"`
-	Password string `json:"password,omitempty" binding:"omitempty,min=8"`
-	Role     string `json:"role,omitempty" binding:"omitempty,oneof=super_user admin technician manager"`
+	Name       string   `json:"name,omitempty"`
+	Email      string   `json:"email,omitempty" binding:"omitempty,email"`
+	Password   string   `json:"password,omitempty" binding:"omitempty,min=8"`
+	Role       string   `json:"role,omitempty" binding:"omitempty,oneof=super_user admin technician manager"`
+	HourlyRate *float64 `json:"hourly_rate,omitempty" binding:"omitempty,min=0"`
+	ManagerID  *string  `json:"manager_id,omitempty"`
 }
 
 type UpdateUserResponse struct {
@@ -0,0 +1,76 @@
+package dto
+
+import "time"
+
+type WorkRequestDTO struct {
+	ID            string     `json:"id"`
+	AssetID       string     `json:"asset_id"`
+	AssetName     string     `json:"asset_name"`
+	RequestedBy   string     `json:"requested_by"`
+	RequesterName string     `json:"requester_name"`
+	Description   string     `json:"description"`
+	Urgency       string     `json:"urgency"`
+	Status        string     `json:"status"`
+	RecordID      *string    `json:"maintenance_record_id,omitempty"`
+	MergedIntoID  *string    `json:"merged_into_id,omitempty"`
+	Feedback      string     `json:"feedback,omitempty"`
+	TriagedBy     *string    `json:"triaged_by,omitempty"`
+	TriagedAt     *time.Time `json:"triaged_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+type CreateWorkRequestRequest struct {
+	AssetID     string `json:"asset_id" binding:"required"`
+	Description string `json:"description" binding:"required,min=5,max=2000"`
+	Urgency     string `json:"urgency" binding:"required,oneof=low medium high critical"`
+}
+
+type CreateWorkRequestResponse struct {
+	Message     string         `json:"message"`
+	WorkRequest WorkRequestDTO `json:"work_request"`
+}
+
+type GetWorkRequestsRequest struct {
+	Page         int    `form:"page,default=1"`
+	ItemsPerPage int    `form:"items_per_page,default=10"`
+	Status       string `form:"status" binding:"omitempty,oneof=open triaged merged rejected"`
+	Urgency      string `form:"urgency" binding:"omitempty,oneof=low medium high critical"`
+	AssetID      string `form:"asset_id"`
+}
+
+type GetWorkRequestsResponse struct {
+	Message      string           `json:"message"`
+	WorkRequests []WorkRequestDTO `json:"work_requests"`
+	TotalItems   int              `json:"total_items"`
+	Page         int              `json:"page"`
+	ItemsPerPage int              `json:"items_per_page"`
+	TotalPages   int              `json:"total_pages"`
+}
+
+type GetWorkRequestByIDResponse struct {
+	Message     string         `json:"message"`
+	WorkRequest WorkRequestDTO `json:"work_request"`
+}
+
+type TriageWorkRequestRequest struct {
+	PerformedBy     *string    `json:"performed_by,omitempty"`
+	Description     string     `json:"description,omitempty" binding:"omitempty,min=5,max=500"`
+	MaintenanceDate *time.Time `json:"maintenance_date,omitempty"`
+	Priority        string     `json:"priority,omitempty" binding:"omitempty,oneof=low medium high critical"`
+	Feedback        string     `json:"feedback,omitempty" binding:"omitempty,max=1000"`
+}
+
+type MergeWorkRequestRequest struct {
+	TargetID string `json:"target_id" binding:"required"`
+	Feedback string `json:"feedback,omitempty" binding:"omitempty,max=1000"`
+}
+
+type RejectWorkRequestRequest struct {
+	Feedback string `json:"feedback" binding:"required,max=1000"`
+}
+
+type WorkRequestDecisionResponse struct {
+	Message     string         `json:"message"`
+	WorkRequest WorkRequestDTO `json:"work_request"`
+}
@@ -1,13 +1,21 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"jaga/config"
 	_ "jaga/docs"
 	"jaga/repositories"
 	"jaga/routes"
+	"jaga/services"
+	"jaga/utils"
 
 	"github.com/joho/godotenv"
 )
@@ -24,13 +32,95 @@ func main() {
 
 	db := config.InitDB()
 	config.AutoMigrate(db)
+	config.LoadSchedulerConfig()
+	config.LoadRecordStatusConfig()
+	config.LoadCommentConfig()
+	config.LoadSLAConfig()
+	config.InitBlobStore()
 
 	userRepository := repositories.NewUserRepository(db)
 	config.SeedSuperUser(userRepository)
 
+	assetRepository := repositories.NewAssetRepository(db)
+	maintenanceScheduleRepository := repositories.NewMaintenanceScheduleRepository(db)
+	maintenanceRecordRepository := repositories.NewMaintenanceRecordRepository(db)
+	holidayCalendarRepository := repositories.NewHolidayCalendarRepository(db)
+	slaRepository := repositories.NewSLARepository(db)
+	notificationRepository := repositories.NewNotificationRepository(db)
+	maintenanceRecordService := services.NewMaintenanceRecordService(maintenanceRecordRepository, assetRepository, maintenanceScheduleRepository, userRepository, repositories.NewChecklistRepository(db), repositories.NewPartRepository(db), repositories.NewLaborEntryRepository(db), repositories.NewApprovalRepository(db), slaRepository, notificationRepository, services.NewRecordStatusMachine(config.RecordStatusTransitions), repositories.NewTxManager(db))
+	assignmentService := services.NewAssignmentService(
+		userRepository,
+		maintenanceRecordRepository,
+		repositories.NewAssetCategoryRepository(db),
+		repositories.NewTechnicianCategoryRepository(db),
+		repositories.NewTxManager(db),
+	)
+
+	var scheduler, slaScheduler *services.Scheduler
+	var leaderElector *services.LeaderElector
+	if config.SchedulerEnabled {
+		scheduler = services.NewScheduler(
+			config.SchedulerInterval,
+			services.SystemClock(),
+			services.NewResumeSchedulesJob(maintenanceScheduleRepository),
+			services.NewDueScheduleJob(maintenanceScheduleRepository, maintenanceRecordService, assignmentService, holidayCalendarRepository),
+			services.NewOverdueSweepJob(maintenanceScheduleRepository, assetRepository, holidayCalendarRepository),
+		)
+		slaScheduler = services.NewScheduler(
+			config.SLAEscalationInterval,
+			services.SystemClock(),
+			services.NewSLAEscalationJob(slaRepository, userRepository, notificationRepository, config.SLAEscalationLead),
+		)
+
+		if config.LeaderElectionEnabled {
+			hostname, _ := os.Hostname()
+			leaderElector = services.NewLeaderElector(
+				repositories.NewSchedulerLeaseRepository(db),
+				"background-jobs",
+				hostname+"-"+utils.GenerateUUID(),
+				config.LeaderLeaseDuration,
+				services.SystemClock(),
+			)
+			leaderElector.Start()
+			scheduler.RequireLeadership(leaderElector)
+			slaScheduler.RequireLeadership(leaderElector)
+		}
+
+		scheduler.Start()
+		log.Printf("Maintenance scheduler running every %s", config.SchedulerInterval)
+		slaScheduler.Start()
+		log.Printf("SLA escalation running every %s", config.SLAEscalationInterval)
+	}
+
 	router := routes.RegisterRoutes()
+	server := &http.Server{Addr: ":8080", Handler: router}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
-	log.Println("Server running on :8080")
-	http.ListenAndServe(":8080", router)
+	go func() {
+		log.Println("Server running on :8080")
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Server failed: %v", err)
+		}
+	}()
 
+	<-ctx.Done()
+	log.Println("Shutting down...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Server shutdown failed: %v", err)
+	}
+
+	if scheduler != nil {
+		scheduler.Stop()
+	}
+	if slaScheduler != nil {
+		slaScheduler.Stop()
+	}
+	if leaderElector != nil {
+		leaderElector.Stop()
+	}
 }
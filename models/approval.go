@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// CategoryApprovalRule decides which finished records of assets in a category
+// need a manager's sign-off before they count as finished.
+type CategoryApprovalRule struct {
+	CategoryID       string `gorm:"primaryKey;type:char(36)"`
+	RequiresApproval bool   `gorm:"not null"`
+	UnscheduledOnly  bool   `gorm:"not null"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// RecordApproval is a manager's decision on a record awaiting approval.
+type RecordApproval struct {
+	ID        string    `gorm:"primaryKey;type:char(36)"`
+	RecordID  string    `gorm:"type:char(36);not null;index"`
+	Decision  string    `gorm:"type:enum('approved','rejected');not null"`
+	Reason    string    `gorm:"type:text"`
+	DecidedBy string    `gorm:"type:char(36);not null"`
+	DecidedAt time.Time `gorm:"not null"`
+
+	Decider User `gorm:"foreignKey:DecidedBy"`
+}
@@ -8,9 +8,12 @@ type Asset struct {
 	CategoryID          string `gorm:"type:char(36);not null"`
 	Location            string `gorm:"type:varchar(100)"`
 	PurchaseDate        *time.Time
+	PurchaseCost        *float64 `gorm:"type:decimal(14,2)"`
 	LastMaintenanceDate *time.Time
 	Condition           string `gorm:"type:varchar(50)"`
 	Status              string `gorm:"type:enum('ready','under_maintenance','need_maintenance');not null"`
+	StatusReason        string `gorm:"type:varchar(255)"`
+	StatusChangedAt     *time.Time
 	AddedBy             string `gorm:"type:char(36)"`
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
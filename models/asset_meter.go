@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type AssetMeter struct {
+	ID            string  `gorm:"primaryKey;type:char(36)"`
+	AssetID       string  `gorm:"type:char(36);not null;index"`
+	MeterType     string  `gorm:"type:enum('run_hours','odometer','cycle_count');not null"`
+	Name          string  `gorm:"type:varchar(100);not null"`
+	Unit          string  `gorm:"type:varchar(20)"`
+	CurrentValue  float64 `gorm:"not null;default:0"`
+	LastReadingAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	Asset Asset `gorm:"foreignKey:AssetID"`
+}
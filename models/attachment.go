@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Attachment is a file stored in the blob store and attached to an asset, a
+// maintenance record or a work request.
+type Attachment struct {
+	ID           string  `gorm:"primaryKey;type:char(36)"`
+	OwnerType    string  `gorm:"type:varchar(20);not null;index:idx_attachment_owner"`
+	OwnerID      string  `gorm:"type:char(36);not null;index:idx_attachment_owner"`
+	Kind         string  `gorm:"type:varchar(20);not null"`
+	FileName     string  `gorm:"type:varchar(255);not null"`
+	ContentType  string  `gorm:"type:varchar(100);not null"`
+	Size         int64   `gorm:"not null"`
+	StorageKey   string  `gorm:"type:varchar(255);not null"`
+	ThumbnailKey *string `gorm:"type:varchar(255)"`
+	UploadedBy   string  `gorm:"type:char(36);not null"`
+	CreatedAt    time.Time
+
+	Uploader User `gorm:"foreignKey:UploadedBy"`
+}
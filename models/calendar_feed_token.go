@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// CalendarFeedToken grants read access to one user's .ics feed. Only the
+// SHA-256 of the token is stored.
+type CalendarFeedToken struct {
+	ID         string `gorm:"primaryKey;type:char(36)"`
+	UserID     string `gorm:"type:char(36);not null;index"`
+	Name       string `gorm:"type:varchar(100)"`
+	TokenHash  string `gorm:"type:char(64);not null;uniqueIndex"`
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+
+	User User `gorm:"foreignKey:UserID"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// ChecklistTemplateItem is one step of the checklist attached to either a
+// maintenance schedule or an asset category.
+type ChecklistTemplateItem struct {
+	ID          string  `gorm:"primaryKey;type:char(36)"`
+	ScheduleID  *string `gorm:"type:char(36);index"`
+	CategoryID  *string `gorm:"type:char(36);index"`
+	Position    int     `gorm:"not null"`
+	Title       string  `gorm:"type:varchar(200);not null"`
+	Description string  `gorm:"type:text"`
+	Required    bool    `gorm:"not null"`
+	Unit        string  `gorm:"type:varchar(20)"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RecordChecklistItem is a step copied onto a maintenance record from its
+// checklist template and ticked off by the technician.
+type RecordChecklistItem struct {
+	ID            string `gorm:"primaryKey;type:char(36)"`
+	RecordID      string `gorm:"type:char(36);not null;index"`
+	Position      int    `gorm:"not null"`
+	Title         string `gorm:"type:varchar(200);not null"`
+	Description   string `gorm:"type:text"`
+	Required      bool   `gorm:"not null"`
+	Unit          string `gorm:"type:varchar(20)"`
+	CompletedAt   *time.Time
+	CompletedBy   *string `gorm:"type:char(36)"`
+	Note          string  `gorm:"type:text"`
+	MeasuredValue *float64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	Completer *User `gorm:"foreignKey:CompletedBy"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// FailureCode is one entry of the failure taxonomy: what went wrong
+// (problem), why it went wrong (cause) or what fixed it (remedy). Retired
+// codes are deactivated rather than deleted so past records keep them.
+type FailureCode struct {
+	ID          string `gorm:"primaryKey;type:char(36)"`
+	Type        string `gorm:"type:enum('problem','cause','remedy');not null;uniqueIndex:idx_failure_codes_type_code"`
+	Code        string `gorm:"type:varchar(20);not null;uniqueIndex:idx_failure_codes_type_code"`
+	Name        string `gorm:"type:varchar(100);not null"`
+	Description string `gorm:"type:text"`
+	Active      bool   `gorm:"not null;default:true"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
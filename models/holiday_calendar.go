@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// HolidayCalendar describes when a site is open: its working weekdays, stored
+// as a comma-separated list such as "mon,tue,wed,thu,fri", and its holidays.
+type HolidayCalendar struct {
+	ID          string `gorm:"primaryKey;type:char(36)"`
+	Name        string `gorm:"type:varchar(100);not null;uniqueIndex"`
+	WorkingDays string `gorm:"type:varchar(27);not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type Holiday struct {
+	ID         string    `gorm:"primaryKey;type:char(36)"`
+	CalendarID string    `gorm:"type:char(36);not null;uniqueIndex:idx_holidays_calendar_date"`
+	Date       time.Time `gorm:"type:date;not null;uniqueIndex:idx_holidays_calendar_date"`
+	Name       string    `gorm:"type:varchar(255)"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
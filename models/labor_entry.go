@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// LaborEntry is time a user spent working on a maintenance record. A running
+// timer has no EndedAt yet; manual entries are logged with a duration after
+// the fact. HourlyRate is the user's rate when the entry was made.
+type LaborEntry struct {
+	ID              string     `gorm:"primaryKey;type:char(36)"`
+	RecordID        string     `gorm:"type:char(36);not null;index"`
+	UserID          string     `gorm:"type:char(36);not null;index"`
+	StartedAt       time.Time  `gorm:"not null;index"`
+	EndedAt         *time.Time `gorm:"index"`
+	DurationSeconds int64      `gorm:"not null;default:0"`
+	Manual          bool       `gorm:"not null"`
+	HourlyRate      *float64   `gorm:"type:decimal(10,2)"`
+	Note            string     `gorm:"type:text"`
+	CreatedAt       time.Time
+
+	User User `gorm:"foreignKey:UserID"`
+}
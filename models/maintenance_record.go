@@ -3,15 +3,27 @@ package models
 import "time"
 
 type MaintenanceRecord struct {
-	ID              string    `gorm:"primaryKey;type:char(36)"`
-	AssetID         string    `gorm:"type:char(36);not null"`
-	ScheduleID      *string   `gorm:"type:char(36)"`
-	PerformedBy     *string   `gorm:"type:char(36)"`
-	Description     string    `gorm:"type:text"`
-	Status          string    `gorm:"type:enum('pending','in_progress','on_hold','finished','failed','cancelled');not null"`
-	MaintenanceDate time.Time `gorm:"not null"`
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	ID                    string     `gorm:"primaryKey;type:char(36)"`
+	AssetID               string     `gorm:"type:char(36);not null"`
+	ScheduleID            *string    `gorm:"type:char(36);uniqueIndex:idx_maintenance_records_schedule_due"`
+	PerformedBy           *string    `gorm:"type:char(36)"`
+	Description           string     `gorm:"type:text"`
+	Status                string     `gorm:"type:enum('pending','in_progress','on_hold','awaiting_approval','finished','failed','cancelled');not null"`
+	MaintenanceDate       time.Time  `gorm:"not null"`
+	DueAt                 *time.Time `gorm:"uniqueIndex:idx_maintenance_records_schedule_due"`
+	ProblemCodeID         *string    `gorm:"type:char(36);index"`
+	CauseCodeID           *string    `gorm:"type:char(36);index"`
+	RemedyCodeID          *string    `gorm:"type:char(36);index"`
+	WorkRequestID         *string    `gorm:"type:char(36);uniqueIndex"`
+	Priority              string     `gorm:"type:enum('low','medium','high','critical');not null;default:'medium';index"`
+	ResponseDueAt         *time.Time `gorm:"index"`
+	ResolutionDueAt       *time.Time `gorm:"index"`
+	RespondedAt           *time.Time
+	SLABreached           bool `gorm:"not null;default:false"`
+	ResponseEscalatedAt   *time.Time
+	ResolutionEscalatedAt *time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
 
 	Asset Asset `gorm:"foreignKey:AssetID"`
 }
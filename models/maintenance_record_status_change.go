@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type MaintenanceRecordStatusChange struct {
+	ID         string    `gorm:"primaryKey;type:char(36)"`
+	RecordID   string    `gorm:"type:char(36);not null;index"`
+	FromStatus string    `gorm:"type:varchar(20);not null"`
+	ToStatus   string    `gorm:"type:varchar(20);not null"`
+	ChangedBy  *string   `gorm:"type:char(36)"`
+	Note       string    `gorm:"type:text"`
+	ChangedAt  time.Time `gorm:"not null;index"`
+
+	Actor *User `gorm:"foreignKey:ChangedBy"`
+}
@@ -7,9 +7,22 @@ type MaintenanceSchedule struct {
 	AssetID             string `gorm:"type:char(36);not null"`
 	ScheduleType        string `gorm:"type:enum('periodic','conditional');not null"`
 	IntervalDays        *int
+	RecurrenceRule      *string `gorm:"type:varchar(255)"`
+	RecurrenceStart     *time.Time
+	MeterID             *string `gorm:"type:char(36);index"`
+	MeterInterval       *float64
+	MeterThreshold      *float64
+	AdvanceFrom         string    `gorm:"type:enum('due_date','completion_date');not null;default:'due_date'"`
 	NextMaintenanceDate time.Time `gorm:"not null"`
-	ScheduledBy         string    `gorm:"type:char(36)"`
-	AssignedTo          string    `gorm:"type:char(36)"`
+	HolidayCalendarID   *string   `gorm:"type:char(36);index"`
+	ClosedDayPolicy     string    `gorm:"type:enum('shift_forward','shift_backward','keep');not null;default:'shift_forward'"`
+	SuspendedAt         *time.Time
+	SuspendedUntil      *time.Time
+	SuspendedBy         *string `gorm:"type:char(36)"`
+	SuspendReason       string  `gorm:"type:varchar(255)"`
+	ResumePolicy        string  `gorm:"type:varchar(30)"`
+	ScheduledBy         string  `gorm:"type:char(36)"`
+	AssignedTo          string  `gorm:"type:char(36)"`
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 
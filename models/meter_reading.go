@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type MeterReading struct {
+	ID         string    `gorm:"primaryKey;type:char(36)"`
+	MeterID    string    `gorm:"type:char(36);not null;index"`
+	Value      float64   `gorm:"not null"`
+	ReadAt     time.Time `gorm:"not null"`
+	RecordedBy string    `gorm:"type:char(36)"`
+	CreatedAt  time.Time
+
+	Meter AssetMeter `gorm:"foreignKey:MeterID"`
+}
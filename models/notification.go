@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// Notification tells a user about something that needs their attention,
+// pointing at the resource it concerns.
+type Notification struct {
+	ID           string  `gorm:"primaryKey;type:char(36)"`
+	UserID       string  `gorm:"type:char(36);not null;index:idx_notifications_user_created"`
+	Type         string  `gorm:"type:varchar(50);not null"`
+	Message      string  `gorm:"type:varchar(500);not null"`
+	ResourceType string  `gorm:"type:varchar(50)"`
+	ResourceID   string  `gorm:"type:char(36)"`
+	ActorID      *string `gorm:"type:char(36)"`
+	ReadAt       *time.Time
+	CreatedAt    time.Time `gorm:"index:idx_notifications_user_created"`
+
+	Actor *User `gorm:"foreignKey:ActorID"`
+}
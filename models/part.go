@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// Part is a spare part kept in stock, such as a filter, belt or bearing.
+// Parts linked to asset categories are only offered to technicians working
+// on assets of those categories; parts without categories fit any asset.
+type Part struct {
+	ID                string   `gorm:"primaryKey;type:char(36)"`
+	SKU               string   `gorm:"type:varchar(50);not null;uniqueIndex"`
+	Name              string   `gorm:"type:varchar(100);not null"`
+	Description       string   `gorm:"type:text"`
+	Unit              string   `gorm:"type:varchar(20);not null"`
+	LowStockThreshold float64  `gorm:"type:decimal(12,3);not null;default:0"`
+	UnitCost          *float64 `gorm:"type:decimal(12,2)"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+
+	Categories []AssetCategory `gorm:"many2many:part_categories;joinForeignKey:PartID;joinReferences:CategoryID"`
+	Stocks     []PartStock     `gorm:"foreignKey:PartID"`
+}
+
+// StockLocation is a place parts are kept, such as a warehouse or a van.
+type StockLocation struct {
+	ID          string `gorm:"primaryKey;type:char(36)"`
+	Name        string `gorm:"type:varchar(100);not null;uniqueIndex"`
+	Description string `gorm:"type:varchar(255)"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// PartStock is the quantity of a part on hand at a location.
+type PartStock struct {
+	PartID     string  `gorm:"primaryKey;type:char(36)"`
+	LocationID string  `gorm:"primaryKey;type:char(36);index"`
+	Quantity   float64 `gorm:"type:decimal(12,3);not null"`
+	UpdatedAt  time.Time
+
+	Location StockLocation `gorm:"foreignKey:LocationID"`
+}
+
+// RecordPart is a quantity of a part used on a maintenance record, taken from
+// a stock location. UnitCost is the part's cost when it was added. DeductedAt
+// is set once the quantity has been taken out of stock, which happens when the
+// record finishes.
+type RecordPart struct {
+	ID         string   `gorm:"primaryKey;type:char(36)"`
+	RecordID   string   `gorm:"type:char(36);not null;index"`
+	PartID     string   `gorm:"type:char(36);not null;index"`
+	LocationID string   `gorm:"type:char(36);not null;index"`
+	Quantity   float64  `gorm:"type:decimal(12,3);not null"`
+	UnitCost   *float64 `gorm:"type:decimal(12,2)"`
+	AddedBy    string   `gorm:"type:char(36);not null"`
+	DeductedAt *time.Time
+	CreatedAt  time.Time
+
+	Part     Part          `gorm:"foreignKey:PartID"`
+	Location StockLocation `gorm:"foreignKey:LocationID"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// RecordComment is a note in a maintenance record's comment thread. Comments
+// are never deleted; their author may only edit them for a short while.
+type RecordComment struct {
+	ID        string `gorm:"primaryKey;type:char(36)"`
+	RecordID  string `gorm:"type:char(36);not null;index"`
+	AuthorID  string `gorm:"type:char(36);not null"`
+	Body      string `gorm:"type:text;not null"`
+	EditedAt  *time.Time
+	CreatedAt time.Time
+
+	Author   User                   `gorm:"foreignKey:AuthorID"`
+	Mentions []RecordCommentMention `gorm:"foreignKey:CommentID"`
+}
+
+// RecordCommentMention is a user mentioned in a comment.
+type RecordCommentMention struct {
+	CommentID string `gorm:"primaryKey;type:char(36)"`
+	UserID    string `gorm:"primaryKey;type:char(36);index"`
+
+	User User `gorm:"foreignKey:UserID"`
+}
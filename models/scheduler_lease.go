@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+type SchedulerLease struct {
+	Name      string    `gorm:"primaryKey;type:varchar(100)"`
+	Holder    string    `gorm:"type:varchar(255);not null"`
+	ExpiresAt time.Time `gorm:"type:datetime(6);not null"`
+	UpdatedAt time.Time
+}
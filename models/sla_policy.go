@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// SLAPolicy sets how soon records of a priority must be responded to, moved to
+// in_progress, and resolved, finished, counted from when they become due.
+type SLAPolicy struct {
+	Priority          string `gorm:"primaryKey;type:enum('low','medium','high','critical')"`
+	ResponseMinutes   int    `gorm:"not null"`
+	ResolutionMinutes int    `gorm:"not null"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// TechnicianCategory marks a technician as preferred for assets of a
+// category when work is assigned automatically.
+type TechnicianCategory struct {
+	UserID     string `gorm:"primaryKey;type:char(36)"`
+	CategoryID string `gorm:"primaryKey;type:char(36);index"`
+	CreatedAt  time.Time
+
+	Category AssetCategory `gorm:"foreignKey:CategoryID"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// VendorInvoice is an external contractor's or supplier's bill for work on a
+// maintenance record.
+type VendorInvoice struct {
+	ID            string    `gorm:"primaryKey;type:char(36)"`
+	RecordID      string    `gorm:"type:char(36);not null;index"`
+	Vendor        string    `gorm:"type:varchar(100);not null"`
+	InvoiceNumber string    `gorm:"type:varchar(50)"`
+	InvoiceDate   time.Time `gorm:"not null"`
+	Amount        float64   `gorm:"type:decimal(14,2);not null"`
+	Description   string    `gorm:"type:text"`
+	AddedBy       string    `gorm:"type:char(36);not null"`
+	CreatedAt     time.Time
+}
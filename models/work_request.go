@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// WorkRequest is a report that an asset needs attention, submitted by any
+// user and triaged by an admin into a maintenance record, merged into an
+// earlier request for the same problem, or rejected.
+type WorkRequest struct {
+	ID           string  `gorm:"primaryKey;type:char(36)"`
+	AssetID      string  `gorm:"type:char(36);not null;index"`
+	RequestedBy  string  `gorm:"type:char(36);not null;index"`
+	Description  string  `gorm:"type:text;not null"`
+	Urgency      string  `gorm:"type:enum('low','medium','high','critical');not null"`
+	Status       string  `gorm:"type:enum('open','triaged','merged','rejected');not null;index"`
+	MergedIntoID *string `gorm:"type:char(36)"`
+	Feedback     string  `gorm:"type:text"`
+	TriagedBy    *string `gorm:"type:char(36)"`
+	TriagedAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+
+	Asset     Asset              `gorm:"foreignKey:AssetID"`
+	Requester User               `gorm:"foreignKey:RequestedBy"`
+	Record    *MaintenanceRecord `gorm:"foreignKey:WorkRequestID"`
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type ApprovalRepository interface {
+	WithTx(tx *gorm.DB) ApprovalRepository
+	GetCategoryApprovalRule(categoryID string) (*models.CategoryApprovalRule, error)
+	SaveCategoryApprovalRule(rule *models.CategoryApprovalRule) error
+	CreateRecordApproval(approval *models.RecordApproval) error
+	GetRecordApprovals(recordID string) ([]models.RecordApproval, error)
+}
+
+type approvalRepository struct {
+	db *gorm.DB
+}
+
+func NewApprovalRepository(db *gorm.DB) ApprovalRepository {
+	return &approvalRepository{db: db}
+}
+
+func (r *approvalRepository) WithTx(tx *gorm.DB) ApprovalRepository {
+	return &approvalRepository{db: tx}
+}
+
+func (r *approvalRepository) GetCategoryApprovalRule(categoryID string) (*models.CategoryApprovalRule, error) {
+	var rule models.CategoryApprovalRule
+	err := r.db.Where("category_id = ?", categoryID).First(&rule).Error
+	return &rule, err
+}
+
+func (r *approvalRepository) SaveCategoryApprovalRule(rule *models.CategoryApprovalRule) error {
+	return r.db.Save(rule).Error
+}
+
+func (r *approvalRepository) CreateRecordApproval(approval *models.RecordApproval) error {
+	return r.db.Omit("Decider").Create(approval).Error
+}
+
+func (r *approvalRepository) GetRecordApprovals(recordID string) ([]models.RecordApproval, error) {
+	var approvals []models.RecordApproval
+	err := r.db.Preload("Decider").
+		Where("record_id = ?", recordID).
+		Order("decided_at asc").
+		Find(&approvals).Error
+	return approvals, err
+}
@@ -2,16 +2,21 @@ package repositories
 
 import (
 	"jaga/models"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type AssetRepository interface {
+	WithTx(tx *gorm.DB) AssetRepository
 	CreateAsset(asset *models.Asset) error
 	GetAssetByID(assetID string) (*models.Asset, error)
+	GetAssetByIDForUpdate(assetID string) (*models.Asset, error)
 	GetAssets(page, itemsPerPage int, sortBy, sortDir, search, categoryID, status string) ([]models.Asset, int64, error)
 	UpdateAsset(asset *models.Asset) error
-	UpdateAssetStatus(assetID, status string) error
+	UpdateAssetStatus(assetID, status, reason string) error
+	UpdateLastMaintenanceDate(assetID string, date time.Time) error
 	DeleteAsset(assetID string) error
 }
 
@@ -23,6 +28,10 @@ func NewAssetRepository(db *gorm.DB) AssetRepository {
 	return &assetRepository{db: db}
 }
 
+func (r *assetRepository) WithTx(tx *gorm.DB) AssetRepository {
+	return &assetRepository{db: tx}
+}
+
 func (r *assetRepository) CreateAsset(asset *models.Asset) error {
 	if err := r.db.Create(asset).Error; err != nil {
 		return err
@@ -38,6 +47,16 @@ func (r *assetRepository) GetAssetByID(assetID string) (*models.Asset, error) {
 	return &asset, nil
 }
 
+// GetAssetByIDForUpdate loads an asset and locks its row until the surrounding
+// transaction ends, so concurrent record updates see each other's changes.
+func (r *assetRepository) GetAssetByIDForUpdate(assetID string) (*models.Asset, error) {
+	var asset models.Asset
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", assetID).First(&asset).Error; err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
 func (r *assetRepository) GetAssets(page, itemsPerPage int, sortBy, sortDir, search, categoryID, status string) ([]models.Asset, int64, error) {
 	var assets []models.Asset
 	var totalItems int64
@@ -85,8 +104,19 @@ func (r *assetRepository) UpdateAsset(asset *models.Asset) error {
 	return nil
 }
 
-func (r *assetRepository) UpdateAssetStatus(assetID, status string) error {
-	return r.db.Model(&models.Asset{}).Where("id = ?", assetID).Update("status", status).Error
+// UpdateAssetStatus sets the status of an asset together with the reason it
+// changed, so automatic changes can be told apart from manual ones.
+func (r *assetRepository) UpdateAssetStatus(assetID, status, reason string) error {
+	return r.db.Model(&models.Asset{}).Where("id = ?", assetID).Updates(map[string]interface{}{
+		"status":            status,
+		"status_reason":     reason,
+		"status_changed_at": time.Now(),
+	}).Error
+}
+
+func (r *assetRepository) UpdateLastMaintenanceDate(assetID string, date time.Time) error {
+	return r.db.Model(&models.Asset{}).Where("id = ?", assetID).
+		UpdateColumn("last_maintenance_date", date).Error
 }
 
 func (r *assetRepository) DeleteAsset(assetID string) error {
@@ -42,6 +42,16 @@ func (r *assetCategoryRepository) UpdateAssetCategory(assetCategory *models.Asse
 	return r.db.Save(assetCategory).Error
 }
 
+// DeleteAssetCategory deletes a category together with its checklist and
+// approval rule.
 func (r *assetCategoryRepository) DeleteAssetCategory(id string) error {
-	return r.db.Delete(&models.AssetCategory{}, "id = ?", id).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("category_id = ?", id).Delete(&models.ChecklistTemplateItem{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("category_id = ?", id).Delete(&models.CategoryApprovalRule{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.AssetCategory{}, "id = ?", id).Error
+	})
 }
@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"jaga/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AssetMeterRepository interface {
+	WithTx(tx *gorm.DB) AssetMeterRepository
+	CreateAssetMeter(meter *models.AssetMeter) error
+	GetAssetMeterByID(meterID string) (*models.AssetMeter, error)
+	GetAssetMeterByIDForUpdate(meterID string) (*models.AssetMeter, error)
+	GetAssetMetersByAssetID(assetID string) ([]models.AssetMeter, error)
+	UpdateAssetMeter(meter *models.AssetMeter) error
+	CreateMeterReading(reading *models.MeterReading) error
+	GetMeterReadings(meterID string, page, itemsPerPage int) ([]models.MeterReading, int64, error)
+}
+
+type assetMeterRepository struct {
+	db *gorm.DB
+}
+
+func NewAssetMeterRepository(db *gorm.DB) AssetMeterRepository {
+	return &assetMeterRepository{db: db}
+}
+
+func (r *assetMeterRepository) WithTx(tx *gorm.DB) AssetMeterRepository {
+	return &assetMeterRepository{db: tx}
+}
+
+func (r *assetMeterRepository) CreateAssetMeter(meter *models.AssetMeter) error {
+	return r.db.Create(meter).Error
+}
+
+func (r *assetMeterRepository) GetAssetMeterByID(meterID string) (*models.AssetMeter, error) {
+	var meter models.AssetMeter
+	if err := r.db.Preload("Asset").Where("id = ?", meterID).First(&meter).Error; err != nil {
+		return nil, err
+	}
+	return &meter, nil
+}
+
+// GetAssetMeterByIDForUpdate loads a meter and locks its row until the
+// surrounding transaction ends, so concurrent readings are applied one at a
+// time.
+func (r *assetMeterRepository) GetAssetMeterByIDForUpdate(meterID string) (*models.AssetMeter, error) {
+	var meter models.AssetMeter
+	if err := r.db.Preload("Asset").Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", meterID).First(&meter).Error; err != nil {
+		return nil, err
+	}
+	return &meter, nil
+}
+
+func (r *assetMeterRepository) GetAssetMetersByAssetID(assetID string) ([]models.AssetMeter, error) {
+	var meters []models.AssetMeter
+	err := r.db.Where("asset_id = ?", assetID).Order("created_at asc").Find(&meters).Error
+	return meters, err
+}
+
+func (r *assetMeterRepository) UpdateAssetMeter(meter *models.AssetMeter) error {
+	return r.db.Omit("Asset").Save(meter).Error
+}
+
+func (r *assetMeterRepository) CreateMeterReading(reading *models.MeterReading) error {
+	return r.db.Omit("Meter").Create(reading).Error
+}
+
+func (r *assetMeterRepository) GetMeterReadings(meterID string, page, itemsPerPage int) ([]models.MeterReading, int64, error) {
+	var readings []models.MeterReading
+	var totalItems int64
+
+	query := r.db.Model(&models.MeterReading{}).Where("meter_id = ?", meterID)
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Order("read_at desc")
+
+	if page > 0 && itemsPerPage > 0 {
+		offset := (page - 1) * itemsPerPage
+		query = query.Limit(itemsPerPage).Offset(offset)
+	}
+
+	if err := query.Find(&readings).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return readings, totalItems, nil
+}
@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type AttachmentRepository interface {
+	CreateAttachment(attachment *models.Attachment) error
+	GetAttachmentByID(attachmentID string) (*models.Attachment, error)
+	GetAttachmentsByOwner(ownerType, ownerID string) ([]models.Attachment, error)
+	DeleteAttachment(attachmentID string) error
+}
+
+type attachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+func (r *attachmentRepository) CreateAttachment(attachment *models.Attachment) error {
+	return r.db.Omit("Uploader").Create(attachment).Error
+}
+
+func (r *attachmentRepository) GetAttachmentByID(attachmentID string) (*models.Attachment, error) {
+	var attachment models.Attachment
+	if err := r.db.Preload("Uploader").Where("id = ?", attachmentID).First(&attachment).Error; err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func (r *attachmentRepository) GetAttachmentsByOwner(ownerType, ownerID string) ([]models.Attachment, error) {
+	var attachments []models.Attachment
+	err := r.db.Preload("Uploader").
+		Where("owner_type = ? AND owner_id = ?", ownerType, ownerID).
+		Order("created_at asc").
+		Find(&attachments).Error
+	return attachments, err
+}
+
+func (r *attachmentRepository) DeleteAttachment(attachmentID string) error {
+	return r.db.Delete(&models.Attachment{}, "id = ?", attachmentID).Error
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"time"
+
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type CalendarFeedTokenRepository interface {
+	CreateCalendarFeedToken(token *models.CalendarFeedToken) error
+	GetCalendarFeedTokenByID(tokenID string) (*models.CalendarFeedToken, error)
+	GetCalendarFeedTokenByHash(tokenHash string) (*models.CalendarFeedToken, error)
+	GetCalendarFeedTokensByUserID(userID string) ([]models.CalendarFeedToken, error)
+	RevokeCalendarFeedToken(tokenID string, revokedAt time.Time) error
+	TouchCalendarFeedToken(tokenID string, usedAt time.Time) error
+}
+
+type calendarFeedTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewCalendarFeedTokenRepository(db *gorm.DB) CalendarFeedTokenRepository {
+	return &calendarFeedTokenRepository{db: db}
+}
+
+func (r *calendarFeedTokenRepository) CreateCalendarFeedToken(token *models.CalendarFeedToken) error {
+	return r.db.Create(token).Error
+}
+
+func (r *calendarFeedTokenRepository) GetCalendarFeedTokenByID(tokenID string) (*models.CalendarFeedToken, error) {
+	var token models.CalendarFeedToken
+	if err := r.db.Where("id = ?", tokenID).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *calendarFeedTokenRepository) GetCalendarFeedTokenByHash(tokenHash string) (*models.CalendarFeedToken, error) {
+	var token models.CalendarFeedToken
+	if err := r.db.Preload("User").Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *calendarFeedTokenRepository) GetCalendarFeedTokensByUserID(userID string) ([]models.CalendarFeedToken, error) {
+	var tokens []models.CalendarFeedToken
+	err := r.db.Where("user_id = ?", userID).Order("created_at desc").Find(&tokens).Error
+	return tokens, err
+}
+
+func (r *calendarFeedTokenRepository) RevokeCalendarFeedToken(tokenID string, revokedAt time.Time) error {
+	return r.db.Model(&models.CalendarFeedToken{}).
+		Where("id = ? AND revoked_at IS NULL", tokenID).
+		Update("revoked_at", revokedAt).Error
+}
+
+// TouchCalendarFeedToken records when the feed was last fetched without
+// bumping updated_at.
+func (r *calendarFeedTokenRepository) TouchCalendarFeedToken(tokenID string, usedAt time.Time) error {
+	return r.db.Model(&models.CalendarFeedToken{}).
+		Where("id = ?", tokenID).
+		UpdateColumn("last_used_at", usedAt).Error
+}
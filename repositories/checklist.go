@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type ChecklistRepository interface {
+	WithTx(tx *gorm.DB) ChecklistRepository
+	GetScheduleChecklist(scheduleID string) ([]models.ChecklistTemplateItem, error)
+	GetCategoryChecklist(categoryID string) ([]models.ChecklistTemplateItem, error)
+	ReplaceScheduleChecklist(scheduleID string, items []models.ChecklistTemplateItem) error
+	ReplaceCategoryChecklist(categoryID string, items []models.ChecklistTemplateItem) error
+	CreateRecordChecklistItems(items []models.RecordChecklistItem) error
+	GetRecordChecklist(recordID string) ([]models.RecordChecklistItem, error)
+	GetRecordChecklistItemByID(itemID string) (*models.RecordChecklistItem, error)
+	UpdateRecordChecklistItem(item *models.RecordChecklistItem) error
+	CountIncompleteRequiredItems(recordID string) (int64, error)
+}
+
+type checklistRepository struct {
+	db *gorm.DB
+}
+
+func NewChecklistRepository(db *gorm.DB) ChecklistRepository {
+	return &checklistRepository{db: db}
+}
+
+func (r *checklistRepository) WithTx(tx *gorm.DB) ChecklistRepository {
+	return &checklistRepository{db: tx}
+}
+
+func (r *checklistRepository) GetScheduleChecklist(scheduleID string) ([]models.ChecklistTemplateItem, error) {
+	var items []models.ChecklistTemplateItem
+	err := r.db.Where("schedule_id = ?", scheduleID).Order("position asc").Find(&items).Error
+	return items, err
+}
+
+func (r *checklistRepository) GetCategoryChecklist(categoryID string) ([]models.ChecklistTemplateItem, error) {
+	var items []models.ChecklistTemplateItem
+	err := r.db.Where("category_id = ?", categoryID).Order("position asc").Find(&items).Error
+	return items, err
+}
+
+// ReplaceScheduleChecklist sets the schedule's checklist to exactly items.
+func (r *checklistRepository) ReplaceScheduleChecklist(scheduleID string, items []models.ChecklistTemplateItem) error {
+	return r.replaceChecklist("schedule_id", scheduleID, items)
+}
+
+// ReplaceCategoryChecklist sets the category's checklist to exactly items.
+func (r *checklistRepository) ReplaceCategoryChecklist(categoryID string, items []models.ChecklistTemplateItem) error {
+	return r.replaceChecklist("category_id", categoryID, items)
+}
+
+func (r *checklistRepository) replaceChecklist(column, ownerID string, items []models.ChecklistTemplateItem) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(column+" = ?", ownerID).Delete(&models.ChecklistTemplateItem{}).Error; err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		return tx.Create(&items).Error
+	})
+}
+
+func (r *checklistRepository) CreateRecordChecklistItems(items []models.RecordChecklistItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return r.db.Create(&items).Error
+}
+
+func (r *checklistRepository) GetRecordChecklist(recordID string) ([]models.RecordChecklistItem, error) {
+	var items []models.RecordChecklistItem
+	err := r.db.Preload("Completer").Where("record_id = ?", recordID).Order("position asc").Find(&items).Error
+	return items, err
+}
+
+func (r *checklistRepository) GetRecordChecklistItemByID(itemID string) (*models.RecordChecklistItem, error) {
+	var item models.RecordChecklistItem
+	if err := r.db.Where("id = ?", itemID).First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *checklistRepository) UpdateRecordChecklistItem(item *models.RecordChecklistItem) error {
+	return r.db.Omit("Completer").Save(item).Error
+}
+
+func (r *checklistRepository) CountIncompleteRequiredItems(recordID string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.RecordChecklistItem{}).
+		Where("record_id = ? AND required = ? AND completed_at IS NULL", recordID, true).
+		Count(&count).Error
+	return count, err
+}
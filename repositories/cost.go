@@ -0,0 +1,179 @@
+package repositories
+
+import (
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+// MaintenanceCosts totals what a set of maintenance records cost. Labor only
+// counts stopped timers and manual entries, and parts of cancelled records
+// are left out because they go back into stock.
+type MaintenanceCosts struct {
+	RecordCount  int
+	LaborSeconds int64
+	LaborCost    float64
+	PartsCost    float64
+	VendorCost   float64
+}
+
+// PurchaseCosts totals the purchase cost of a set of assets.
+type PurchaseCosts struct {
+	AssetCount   int
+	PurchaseCost float64
+}
+
+type CostRepository interface {
+	GetAssetMaintenanceCosts(assetID string, from, to *time.Time) (*MaintenanceCosts, error)
+	GetCategoryMaintenanceCosts(from, to time.Time) (map[string]*MaintenanceCosts, error)
+	GetCategoryPurchaseCosts(from, to time.Time) (map[string]*PurchaseCosts, error)
+}
+
+type costRepository struct {
+	db *gorm.DB
+}
+
+func NewCostRepository(db *gorm.DB) CostRepository {
+	return &costRepository{db: db}
+}
+
+// GetAssetMaintenanceCosts totals the costs of an asset's records, optionally
+// limited to records with a maintenance date in [from, to).
+func (r *costRepository) GetAssetMaintenanceCosts(assetID string, from, to *time.Time) (*MaintenanceCosts, error) {
+	costs, err := r.sumMaintenanceCosts("mr.asset_id", func(query *gorm.DB) *gorm.DB {
+		query = query.Where("mr.asset_id = ?", assetID)
+		if from != nil {
+			query = query.Where("mr.maintenance_date >= ?", *from)
+		}
+		if to != nil {
+			query = query.Where("mr.maintenance_date < ?", *to)
+		}
+		return query
+	})
+	if err != nil {
+		return nil, err
+	}
+	if total, ok := costs[assetID]; ok {
+		return total, nil
+	}
+	return &MaintenanceCosts{}, nil
+}
+
+// GetCategoryMaintenanceCosts totals the costs of the records with a
+// maintenance date in [from, to) per asset category.
+func (r *costRepository) GetCategoryMaintenanceCosts(from, to time.Time) (map[string]*MaintenanceCosts, error) {
+	return r.sumMaintenanceCosts("a.category_id", func(query *gorm.DB) *gorm.DB {
+		return query.Where("mr.maintenance_date >= ? AND mr.maintenance_date < ?", from, to)
+	})
+}
+
+// GetCategoryPurchaseCosts totals the purchase cost of the assets bought in
+// [from, to) per asset category.
+func (r *costRepository) GetCategoryPurchaseCosts(from, to time.Time) (map[string]*PurchaseCosts, error) {
+	var rows []struct {
+		ID           string
+		AssetCount   int
+		PurchaseCost float64
+	}
+	err := r.db.Model(&models.Asset{}).
+		Select("category_id AS id, COUNT(*) AS asset_count, COALESCE(SUM(purchase_cost), 0) AS purchase_cost").
+		Where("purchase_date >= ? AND purchase_date < ?", from, to).
+		Group("category_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	costs := make(map[string]*PurchaseCosts, len(rows))
+	for _, row := range rows {
+		costs[row.ID] = &PurchaseCosts{AssetCount: row.AssetCount, PurchaseCost: row.PurchaseCost}
+	}
+	return costs, nil
+}
+
+// sumMaintenanceCosts totals record counts, labor, parts and vendor invoices
+// of the records selected by scope, grouped by groupColumn. Scope and group
+// may refer to the record as mr and to its asset as a.
+func (r *costRepository) sumMaintenanceCosts(groupColumn string, scope func(*gorm.DB) *gorm.DB) (map[string]*MaintenanceCosts, error) {
+	base := func() *gorm.DB {
+		return scope(r.db.Table("maintenance_records mr").Joins("JOIN assets a ON a.id = mr.asset_id"))
+	}
+	costs := map[string]*MaintenanceCosts{}
+	get := func(id string) *MaintenanceCosts {
+		if costs[id] == nil {
+			costs[id] = &MaintenanceCosts{}
+		}
+		return costs[id]
+	}
+
+	var counts []struct {
+		ID    string
+		Total int
+	}
+	err := base().
+		Select(groupColumn + " AS id, COUNT(*) AS total").
+		Group(groupColumn).
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range counts {
+		get(row.ID).RecordCount = row.Total
+	}
+
+	var labor []struct {
+		ID      string
+		Seconds int64
+		Cost    float64
+	}
+	err = base().
+		Joins("JOIN labor_entries le ON le.record_id = mr.id AND le.ended_at IS NOT NULL").
+		Select(groupColumn + " AS id, SUM(le.duration_seconds) AS seconds, SUM(le.duration_seconds * COALESCE(le.hourly_rate, 0) / 3600) AS cost").
+		Group(groupColumn).
+		Scan(&labor).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range labor {
+		get(row.ID).LaborSeconds = row.Seconds
+		get(row.ID).LaborCost = row.Cost
+	}
+
+	var parts []struct {
+		ID   string
+		Cost float64
+	}
+	err = base().
+		Joins("JOIN record_parts rp ON rp.record_id = mr.id").
+		Where("mr.status <> ?", consts.RecordStatusCancelled).
+		Select(groupColumn + " AS id, SUM(rp.quantity * COALESCE(rp.unit_cost, 0)) AS cost").
+		Group(groupColumn).
+		Scan(&parts).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range parts {
+		get(row.ID).PartsCost = row.Cost
+	}
+
+	var vendor []struct {
+		ID   string
+		Cost float64
+	}
+	err = base().
+		Joins("JOIN vendor_invoices vi ON vi.record_id = mr.id").
+		Select(groupColumn + " AS id, SUM(vi.amount) AS cost").
+		Group(groupColumn).
+		Scan(&vendor).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range vendor {
+		get(row.ID).VendorCost = row.Cost
+	}
+
+	return costs, nil
+}
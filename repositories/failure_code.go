@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type FailureCodeRepository interface {
+	CreateFailureCode(code *models.FailureCode) error
+	GetFailureCodes(codeType string, includeInactive bool) ([]models.FailureCode, error)
+	GetFailureCodeByID(codeID string) (*models.FailureCode, error)
+	UpdateFailureCode(code *models.FailureCode) error
+	DeleteFailureCode(codeID string) error
+	IsFailureCodeInUse(codeID string) (bool, error)
+}
+
+type failureCodeRepository struct {
+	db *gorm.DB
+}
+
+func NewFailureCodeRepository(db *gorm.DB) FailureCodeRepository {
+	return &failureCodeRepository{db: db}
+}
+
+func (r *failureCodeRepository) CreateFailureCode(code *models.FailureCode) error {
+	return r.db.Create(code).Error
+}
+
+func (r *failureCodeRepository) GetFailureCodes(codeType string, includeInactive bool) ([]models.FailureCode, error) {
+	var codes []models.FailureCode
+	query := r.db.Model(&models.FailureCode{})
+	if codeType != "" {
+		query = query.Where("type = ?", codeType)
+	}
+	if !includeInactive {
+		query = query.Where("active = ?", true)
+	}
+	err := query.Order("type asc, code asc").Find(&codes).Error
+	return codes, err
+}
+
+func (r *failureCodeRepository) GetFailureCodeByID(codeID string) (*models.FailureCode, error) {
+	var code models.FailureCode
+	if err := r.db.Where("id = ?", codeID).First(&code).Error; err != nil {
+		return nil, err
+	}
+	return &code, nil
+}
+
+func (r *failureCodeRepository) UpdateFailureCode(code *models.FailureCode) error {
+	return r.db.Save(code).Error
+}
+
+func (r *failureCodeRepository) DeleteFailureCode(codeID string) error {
+	return r.db.Delete(&models.FailureCode{}, "id = ?", codeID).Error
+}
+
+// IsFailureCodeInUse reports whether any maintenance record carries the code.
+func (r *failureCodeRepository) IsFailureCodeInUse(codeID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.MaintenanceRecord{}).
+		Where("problem_code_id = ? OR cause_code_id = ? OR remedy_code_id = ?", codeID, codeID, codeID).
+		Count(&count).Error
+	return count > 0, err
+}
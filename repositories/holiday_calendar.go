@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"time"
+
+	"jaga/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type HolidayCalendarRepository interface {
+	CreateHolidayCalendar(calendar *models.HolidayCalendar) error
+	GetHolidayCalendars() ([]models.HolidayCalendar, error)
+	GetHolidayCalendarByID(calendarID string) (*models.HolidayCalendar, error)
+	UpdateHolidayCalendar(calendar *models.HolidayCalendar) error
+	DeleteHolidayCalendar(calendarID string) error
+	IsHolidayCalendarInUse(calendarID string) (bool, error)
+	GetHolidays(calendarID string, from, to *time.Time) ([]models.Holiday, error)
+	GetHolidayByID(holidayID string) (*models.Holiday, error)
+	UpsertHolidays(holidays []models.Holiday) error
+	DeleteHoliday(holidayID string) error
+}
+
+type holidayCalendarRepository struct {
+	db *gorm.DB
+}
+
+func NewHolidayCalendarRepository(db *gorm.DB) HolidayCalendarRepository {
+	return &holidayCalendarRepository{db: db}
+}
+
+func (r *holidayCalendarRepository) CreateHolidayCalendar(calendar *models.HolidayCalendar) error {
+	return r.db.Create(calendar).Error
+}
+
+func (r *holidayCalendarRepository) GetHolidayCalendars() ([]models.HolidayCalendar, error) {
+	var calendars []models.HolidayCalendar
+	err := r.db.Order("name asc").Find(&calendars).Error
+	return calendars, err
+}
+
+func (r *holidayCalendarRepository) GetHolidayCalendarByID(calendarID string) (*models.HolidayCalendar, error) {
+	var calendar models.HolidayCalendar
+	if err := r.db.Where("id = ?", calendarID).First(&calendar).Error; err != nil {
+		return nil, err
+	}
+	return &calendar, nil
+}
+
+func (r *holidayCalendarRepository) UpdateHolidayCalendar(calendar *models.HolidayCalendar) error {
+	return r.db.Save(calendar).Error
+}
+
+func (r *holidayCalendarRepository) DeleteHolidayCalendar(calendarID string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("calendar_id = ?", calendarID).Delete(&models.Holiday{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.HolidayCalendar{}, "id = ?", calendarID).Error
+	})
+}
+
+func (r *holidayCalendarRepository) IsHolidayCalendarInUse(calendarID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.MaintenanceSchedule{}).
+		Where("holiday_calendar_id = ?", calendarID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *holidayCalendarRepository) GetHolidays(calendarID string, from, to *time.Time) ([]models.Holiday, error) {
+	var holidays []models.Holiday
+
+	query := r.db.Where("calendar_id = ?", calendarID)
+	if from != nil {
+		query = query.Where("date >= ?", from.Format("2006-01-02"))
+	}
+	if to != nil {
+		query = query.Where("date <= ?", to.Format("2006-01-02"))
+	}
+
+	err := query.Order("date asc").Find(&holidays).Error
+	return holidays, err
+}
+
+func (r *holidayCalendarRepository) GetHolidayByID(holidayID string) (*models.Holiday, error) {
+	var holiday models.Holiday
+	if err := r.db.Where("id = ?", holidayID).First(&holiday).Error; err != nil {
+		return nil, err
+	}
+	return &holiday, nil
+}
+
+// UpsertHolidays inserts holidays, renaming the existing holiday when the
+// calendar already has one on that date.
+func (r *holidayCalendarRepository) UpsertHolidays(holidays []models.Holiday) error {
+	if len(holidays) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "calendar_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "updated_at"}),
+	}).CreateInBatches(&holidays, 200).Error
+}
+
+func (r *holidayCalendarRepository) DeleteHoliday(holidayID string) error {
+	return r.db.Delete(&models.Holiday{}, "id = ?", holidayID).Error
+}
@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+// LaborTotal is the logged time of one record, asset or technician.
+type LaborTotal struct {
+	ID           string
+	Name         string
+	TotalSeconds int64
+	EntryCount   int
+}
+
+type LaborEntryRepository interface {
+	WithTx(tx *gorm.DB) LaborEntryRepository
+	CreateLaborEntry(entry *models.LaborEntry) error
+	GetLaborEntryByID(entryID string) (*models.LaborEntry, error)
+	GetLaborEntriesByRecord(recordID string) ([]models.LaborEntry, error)
+	GetRunningLaborEntryByUser(userID string) (*models.LaborEntry, error)
+	StopLaborEntry(entry *models.LaborEntry, endedAt time.Time) error
+	StopRunningLaborEntries(recordID string, endedAt time.Time) error
+	DeleteLaborEntry(entryID string) error
+	GetLaborTotals(groupBy string, from, to time.Time) ([]LaborTotal, error)
+}
+
+type laborEntryRepository struct {
+	db *gorm.DB
+}
+
+func NewLaborEntryRepository(db *gorm.DB) LaborEntryRepository {
+	return &laborEntryRepository{db: db}
+}
+
+func (r *laborEntryRepository) WithTx(tx *gorm.DB) LaborEntryRepository {
+	return &laborEntryRepository{db: tx}
+}
+
+func (r *laborEntryRepository) CreateLaborEntry(entry *models.LaborEntry) error {
+	return r.db.Omit("User").Create(entry).Error
+}
+
+func (r *laborEntryRepository) GetLaborEntryByID(entryID string) (*models.LaborEntry, error) {
+	var entry models.LaborEntry
+	err := r.db.Preload("User").Where("id = ?", entryID).First(&entry).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *laborEntryRepository) GetLaborEntriesByRecord(recordID string) ([]models.LaborEntry, error) {
+	var entries []models.LaborEntry
+	err := r.db.Preload("User").
+		Where("record_id = ?", recordID).
+		Order("started_at asc").
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *laborEntryRepository) GetRunningLaborEntryByUser(userID string) (*models.LaborEntry, error) {
+	var entry models.LaborEntry
+	err := r.db.Where("user_id = ? AND ended_at IS NULL", userID).First(&entry).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *laborEntryRepository) StopLaborEntry(entry *models.LaborEntry, endedAt time.Time) error {
+	entry.EndedAt = &endedAt
+	entry.DurationSeconds = int64(endedAt.Sub(entry.StartedAt).Seconds())
+	return r.db.Model(&models.LaborEntry{}).
+		Where("id = ? AND ended_at IS NULL", entry.ID).
+		Updates(map[string]interface{}{
+			"ended_at":         entry.EndedAt,
+			"duration_seconds": entry.DurationSeconds,
+		}).Error
+}
+
+// StopRunningLaborEntries stops every running timer on a record at endedAt.
+func (r *laborEntryRepository) StopRunningLaborEntries(recordID string, endedAt time.Time) error {
+	return r.db.Model(&models.LaborEntry{}).
+		Where("record_id = ? AND ended_at IS NULL", recordID).
+		Updates(map[string]interface{}{
+			"ended_at":         endedAt,
+			"duration_seconds": gorm.Expr("GREATEST(TIMESTAMPDIFF(SECOND, started_at, ?), 0)", endedAt),
+		}).Error
+}
+
+func (r *laborEntryRepository) DeleteLaborEntry(entryID string) error {
+	return r.db.Delete(&models.LaborEntry{}, "id = ?", entryID).Error
+}
+
+// GetLaborTotals sums the finished labor entries started within [from, to)
+// per record, asset or technician, largest first.
+func (r *laborEntryRepository) GetLaborTotals(groupBy string, from, to time.Time) ([]LaborTotal, error) {
+	query := r.db.Table("labor_entries le").
+		Where("le.ended_at IS NOT NULL AND le.started_at >= ? AND le.started_at < ?", from, to)
+
+	switch groupBy {
+	case consts.LaborGroupByRecord:
+		query = query.
+			Joins("JOIN maintenance_records mr ON mr.id = le.record_id").
+			Joins("JOIN assets a ON a.id = mr.asset_id").
+			Select("le.record_id AS id, a.name AS name, SUM(le.duration_seconds) AS total_seconds, COUNT(*) AS entry_count").
+			Group("le.record_id, a.name")
+	case consts.LaborGroupByAsset:
+		query = query.
+			Joins("JOIN maintenance_records mr ON mr.id = le.record_id").
+			Joins("JOIN assets a ON a.id = mr.asset_id").
+			Select("a.id AS id, a.name AS name, SUM(le.duration_seconds) AS total_seconds, COUNT(*) AS entry_count").
+			Group("a.id, a.name")
+	default:
+		query = query.
+			Joins("JOIN users u ON u.id = le.user_id").
+			Select("u.id AS id, u.name AS name, SUM(le.duration_seconds) AS total_seconds, COUNT(*) AS entry_count").
+			Group("u.id, u.name")
+	}
+
+	var totals []LaborTotal
+	err := query.Order("total_seconds desc").Scan(&totals).Error
+	return totals, err
+}
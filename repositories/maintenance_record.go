@@ -1,20 +1,35 @@
 package repositories
 
 import (
+	"jaga/consts"
 	"jaga/models"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type MaintenanceRecordRepository interface {
+	WithTx(tx *gorm.DB) MaintenanceRecordRepository
 	CreateMaintenanceRecord(record *models.MaintenanceRecord) error
 	GetMaintenanceRecordByID(recordID string) (*models.MaintenanceRecord, error)
+	GetMaintenanceRecordByIDForUpdate(recordID string) (*models.MaintenanceRecord, error)
 	GetMaintenanceRecords(
 		page, itemsPerPage int,
-		sortBy, sortDir, assetID, status string,
+		sortBy, sortDir, assetID, status, performedBy string,
 		scheduleIDs ...string) ([]models.MaintenanceRecord, int64, error)
+	GetMaintenanceRecordsInRange(from, to time.Time, assetID, performedBy string) ([]models.MaintenanceRecord, error)
+	HasOpenMaintenanceRecord(scheduleID string) (bool, error)
+	HasOtherOpenMaintenanceRecords(assetID, recordID string) (bool, error)
+	GetOpenMaintenanceRecordsByPerformer(performedBy string) ([]models.MaintenanceRecord, error)
+	GetMaintenanceRecordsByStatus(status string) ([]models.MaintenanceRecord, error)
+	CountOpenMaintenanceRecordsByPerformer() (map[string]int, error)
+	UpdateMaintenanceRecordPerformer(recordID string, performedBy *string) error
+	UpdateMaintenanceRecordFailureCodes(recordID string, problemCodeID, causeCodeID, remedyCodeID *string) error
 	UpdateMaintenanceRecord(record *models.MaintenanceRecord) error
 	DeleteMaintenanceRecord(recordID string) error
+	CreateStatusChange(change *models.MaintenanceRecordStatusChange) error
+	GetStatusChanges(recordID string) ([]models.MaintenanceRecordStatusChange, error)
 }
 
 type maintenanceRecordRepository struct {
@@ -25,6 +40,10 @@ func NewMaintenanceRecordRepository(db *gorm.DB) MaintenanceRecordRepository {
 	return &maintenanceRecordRepository{db: db}
 }
 
+func (r *maintenanceRecordRepository) WithTx(tx *gorm.DB) MaintenanceRecordRepository {
+	return &maintenanceRecordRepository{db: tx}
+}
+
 func (r *maintenanceRecordRepository) CreateMaintenanceRecord(record *models.MaintenanceRecord) error {
 	return r.db.Create(record).Error
 }
@@ -38,9 +57,19 @@ func (r *maintenanceRecordRepository) GetMaintenanceRecordByID(recordID string)
 	return &record, nil
 }
 
+// GetMaintenanceRecordByIDForUpdate loads a record and locks its row until the
+// surrounding transaction ends, so concurrent status changes are serialized.
+func (r *maintenanceRecordRepository) GetMaintenanceRecordByIDForUpdate(recordID string) (*models.MaintenanceRecord, error) {
+	var record models.MaintenanceRecord
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", recordID).First(&record).Error; err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
 func (r *maintenanceRecordRepository) GetMaintenanceRecords(
 	page, itemsPerPage int,
-	sortBy, sortDir, assetID, status string,
+	sortBy, sortDir, assetID, status, performedBy string,
 	scheduleIDs ...string,
 ) ([]models.MaintenanceRecord, int64, error) {
 
@@ -55,6 +84,9 @@ func (r *maintenanceRecordRepository) GetMaintenanceRecords(
 	if status != "" {
 		query = query.Where("status = ?", status)
 	}
+	if performedBy != "" {
+		query = query.Where("performed_by = ?", performedBy)
+	}
 
 	if len(scheduleIDs) > 0 {
 		query = query.Where("schedule_id IN (?)", scheduleIDs)
@@ -64,14 +96,19 @@ func (r *maintenanceRecordRepository) GetMaintenanceRecords(
 		return nil, 0, err
 	}
 
-	if sortBy != "" {
-		order := sortBy
-		if sortDir != "" {
-			order = sortBy + " " + sortDir
-		}
-		query = query.Order(order)
-	} else {
+	switch sortBy {
+	case consts.RecordSortPriority:
+		// Descending puts the most urgent records first.
+		query = query.Order(withSortDir("FIELD(priority, 'low', 'medium', 'high', 'critical')", sortDir))
+	case consts.RecordSortSLADeadline:
+		// The next deadline is the response one until work starts. Records
+		// without deadlines come last either way.
+		deadline := "CASE WHEN responded_at IS NULL THEN response_due_at ELSE resolution_due_at END"
+		query = query.Order(deadline + " IS NULL").Order(withSortDir(deadline, sortDir))
+	case "":
 		query = query.Order("maintenance_date desc")
+	default:
+		query = query.Order(withSortDir(sortBy, sortDir))
 	}
 
 	if page > 0 && itemsPerPage > 0 {
@@ -86,10 +123,154 @@ func (r *maintenanceRecordRepository) GetMaintenanceRecords(
 	return records, totalItems, nil
 }
 
+func withSortDir(expr, sortDir string) string {
+	if sortDir == "" {
+		return expr
+	}
+	return expr + " " + sortDir
+}
+
+// GetMaintenanceRecordsInRange returns the records whose maintenance date or
+// due date falls within [from, to].
+func (r *maintenanceRecordRepository) GetMaintenanceRecordsInRange(from, to time.Time, assetID, performedBy string) ([]models.MaintenanceRecord, error) {
+	var records []models.MaintenanceRecord
+
+	query := r.db.Preload("Asset").
+		Where("(maintenance_date BETWEEN ? AND ?) OR (due_at BETWEEN ? AND ?)", from, to, from, to)
+	if assetID != "" {
+		query = query.Where("asset_id = ?", assetID)
+	}
+	if performedBy != "" {
+		query = query.Where("performed_by = ?", performedBy)
+	}
+
+	if err := query.Order("maintenance_date asc").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (r *maintenanceRecordRepository) HasOpenMaintenanceRecord(scheduleID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.MaintenanceRecord{}).
+		Where("schedule_id = ? AND status IN (?)", scheduleID, consts.OpenMaintenanceRecordStatuses).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// HasOtherOpenMaintenanceRecords reports whether the asset has open records
+// besides recordID.
+func (r *maintenanceRecordRepository) HasOtherOpenMaintenanceRecords(assetID, recordID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.MaintenanceRecord{}).
+		Where("asset_id = ? AND id <> ? AND status IN (?)", assetID, recordID, consts.OpenMaintenanceRecordStatuses).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *maintenanceRecordRepository) GetOpenMaintenanceRecordsByPerformer(performedBy string) ([]models.MaintenanceRecord, error) {
+	var records []models.MaintenanceRecord
+	err := r.db.Preload("Asset").
+		Where("performed_by = ? AND status IN (?)", performedBy, consts.OpenMaintenanceRecordStatuses).
+		Order("maintenance_date asc").
+		Find(&records).Error
+	return records, err
+}
+
+func (r *maintenanceRecordRepository) GetMaintenanceRecordsByStatus(status string) ([]models.MaintenanceRecord, error) {
+	var records []models.MaintenanceRecord
+	err := r.db.Preload("Asset").
+		Where("status = ?", status).
+		Order("maintenance_date asc").
+		Find(&records).Error
+	return records, err
+}
+
+// CountOpenMaintenanceRecordsByPerformer returns the number of open records
+// per performer. Performers without open records are absent from the map.
+func (r *maintenanceRecordRepository) CountOpenMaintenanceRecordsByPerformer() (map[string]int, error) {
+	var rows []struct {
+		PerformedBy string
+		Total       int
+	}
+	err := r.db.Model(&models.MaintenanceRecord{}).
+		Select("performed_by, COUNT(*) AS total").
+		Where("performed_by IS NOT NULL AND status IN (?)", consts.OpenMaintenanceRecordStatuses).
+		Group("performed_by").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.PerformedBy] = row.Total
+	}
+	return counts, nil
+}
+
+func (r *maintenanceRecordRepository) UpdateMaintenanceRecordPerformer(recordID string, performedBy *string) error {
+	return r.db.Model(&models.MaintenanceRecord{}).
+		Where("id = ?", recordID).
+		Update("performed_by", performedBy).Error
+}
+
+func (r *maintenanceRecordRepository) UpdateMaintenanceRecordFailureCodes(recordID string, problemCodeID, causeCodeID, remedyCodeID *string) error {
+	return r.db.Model(&models.MaintenanceRecord{}).
+		Where("id = ?", recordID).
+		Updates(map[string]interface{}{
+			"problem_code_id": problemCodeID,
+			"cause_code_id":   causeCodeID,
+			"remedy_code_id":  remedyCodeID,
+		}).Error
+}
+
 func (r *maintenanceRecordRepository) UpdateMaintenanceRecord(record *models.MaintenanceRecord) error {
 	return r.db.Save(record).Error
 }
 
+// DeleteMaintenanceRecord deletes a record together with its checklist and
+// status history.
 func (r *maintenanceRecordRepository) DeleteMaintenanceRecord(recordID string) error {
-	return r.db.Delete(&models.MaintenanceRecord{}, "id = ?", recordID).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("record_id = ?", recordID).Delete(&models.RecordChecklistItem{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("record_id = ?", recordID).Delete(&models.MaintenanceRecordStatusChange{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("record_id = ?", recordID).Delete(&models.RecordPart{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("record_id = ?", recordID).Delete(&models.LaborEntry{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("record_id = ?", recordID).Delete(&models.VendorInvoice{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("comment_id IN (?)", tx.Model(&models.RecordComment{}).Select("id").Where("record_id = ?", recordID)).
+			Delete(&models.RecordCommentMention{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("record_id = ?", recordID).Delete(&models.RecordComment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("record_id = ?", recordID).Delete(&models.RecordApproval{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.MaintenanceRecord{}, "id = ?", recordID).Error
+	})
+}
+
+func (r *maintenanceRecordRepository) CreateStatusChange(change *models.MaintenanceRecordStatusChange) error {
+	return r.db.Create(change).Error
+}
+
+func (r *maintenanceRecordRepository) GetStatusChanges(recordID string) ([]models.MaintenanceRecordStatusChange, error) {
+	var changes []models.MaintenanceRecordStatusChange
+	err := r.db.Preload("Actor").
+		Where("record_id = ?", recordID).
+		Order("changed_at asc").
+		Find(&changes).Error
+	return changes, err
 }
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"jaga/consts"
 	"jaga/models"
 	"time"
 
@@ -8,9 +9,15 @@ import (
 )
 
 type MaintenanceScheduleRepository interface {
+	WithTx(tx *gorm.DB) MaintenanceScheduleRepository
 	CreateMaintenanceSchedule(schedule *models.MaintenanceSchedule) error
 	GetMaintenanceScheduleByID(scheduleID string) (*models.MaintenanceSchedule, error)
-	GetMaintenanceSchedules(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleType string, startDate, endDate *time.Time) ([]models.MaintenanceSchedule, int64, error)
+	GetMaintenanceSchedules(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleType, assignedTo string, startDate, endDate *time.Time) ([]models.MaintenanceSchedule, int64, error)
+	GetMaintenanceSchedulesForCalendar(assetID, assignedTo string) ([]models.MaintenanceSchedule, error)
+	GetDueMaintenanceSchedules(dueBefore time.Time) ([]models.MaintenanceSchedule, error)
+	GetMaintenanceSchedulesByMeterID(meterID string) ([]models.MaintenanceSchedule, error)
+	GetOverdueMaintenanceSchedules(now time.Time) ([]models.MaintenanceSchedule, error)
+	GetMaintenanceSchedulesToResume(now time.Time) ([]models.MaintenanceSchedule, error)
 	UpdateMaintenanceSchedule(schedule *models.MaintenanceSchedule) error
 	DeleteMaintenanceSchedule(scheduleID string) error
 }
@@ -23,6 +30,10 @@ func NewMaintenanceScheduleRepository(db *gorm.DB) MaintenanceScheduleRepository
 	return &maintenanceScheduleRepository{db: db}
 }
 
+func (r *maintenanceScheduleRepository) WithTx(tx *gorm.DB) MaintenanceScheduleRepository {
+	return &maintenanceScheduleRepository{db: tx}
+}
+
 func (r *maintenanceScheduleRepository) CreateMaintenanceSchedule(schedule *models.MaintenanceSchedule) error {
 	return r.db.Create(schedule).Error
 }
@@ -36,7 +47,7 @@ func (r *maintenanceScheduleRepository) GetMaintenanceScheduleByID(scheduleID st
 	return &schedule, nil
 }
 
-func (r *maintenanceScheduleRepository) GetMaintenanceSchedules(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleType string, startDate, endDate *time.Time) ([]models.MaintenanceSchedule, int64, error) {
+func (r *maintenanceScheduleRepository) GetMaintenanceSchedules(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleType, assignedTo string, startDate, endDate *time.Time) ([]models.MaintenanceSchedule, int64, error) {
 	var schedules []models.MaintenanceSchedule
 	var totalItems int64
 
@@ -48,6 +59,9 @@ func (r *maintenanceScheduleRepository) GetMaintenanceSchedules(page, itemsPerPa
 	if scheduleType != "" {
 		query = query.Where("schedule_type = ?", scheduleType)
 	}
+	if assignedTo != "" {
+		query = query.Where("assigned_to = ?", assignedTo)
+	}
 
 	if startDate != nil && endDate != nil {
 		query = query.Where("next_maintenance_date BETWEEN ? AND ?", *startDate, *endDate)
@@ -83,10 +97,107 @@ func (r *maintenanceScheduleRepository) GetMaintenanceSchedules(page, itemsPerPa
 	return schedules, totalItems, nil
 }
 
+func (r *maintenanceScheduleRepository) GetMaintenanceSchedulesForCalendar(assetID, assignedTo string) ([]models.MaintenanceSchedule, error) {
+	var schedules []models.MaintenanceSchedule
+
+	query := r.db.Preload("Asset").Where("meter_id IS NULL")
+	if assetID != "" {
+		query = query.Where("asset_id = ?", assetID)
+	}
+	if assignedTo != "" {
+		query = query.Where("assigned_to = ?", assignedTo)
+	}
+
+	if err := query.Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// GetDueMaintenanceSchedules returns date-driven schedules whose next maintenance
+// date is at or before dueBefore and that have neither an open maintenance
+// record nor any record for that occurrence yet. Meter-driven schedules are
+// triggered by readings instead, and suspended schedules are skipped.
+func (r *maintenanceScheduleRepository) GetDueMaintenanceSchedules(dueBefore time.Time) ([]models.MaintenanceSchedule, error) {
+	var schedules []models.MaintenanceSchedule
+
+	openRecords := r.db.Model(&models.MaintenanceRecord{}).
+		Select("1").
+		Where("maintenance_records.schedule_id = maintenance_schedules.id").
+		Where("maintenance_records.status IN (?)", consts.OpenMaintenanceRecordStatuses)
+	// A closed record does not always move the schedule on, e.g. when it
+	// failed, so the occurrence it was generated for must not come due again.
+	occurrenceRecords := r.db.Model(&models.MaintenanceRecord{}).
+		Select("1").
+		Where("maintenance_records.schedule_id = maintenance_schedules.id").
+		Where("maintenance_records.due_at = maintenance_schedules.next_maintenance_date")
+
+	err := r.db.Preload("Asset").
+		Where("next_maintenance_date <= ?", dueBefore).
+		Where("meter_id IS NULL").
+		Where("suspended_at IS NULL").
+		Where("NOT EXISTS (?)", openRecords).
+		Where("NOT EXISTS (?)", occurrenceRecords).
+		Order("next_maintenance_date asc").
+		Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// GetOverdueMaintenanceSchedules returns date-driven schedules whose next
+// maintenance date has passed without a finished record for that occurrence.
+// Suspended schedules are never overdue.
+func (r *maintenanceScheduleRepository) GetOverdueMaintenanceSchedules(now time.Time) ([]models.MaintenanceSchedule, error) {
+	var schedules []models.MaintenanceSchedule
+
+	finishedRecords := r.db.Model(&models.MaintenanceRecord{}).
+		Select("1").
+		Where("maintenance_records.schedule_id = maintenance_schedules.id").
+		Where("maintenance_records.due_at = maintenance_schedules.next_maintenance_date").
+		Where("maintenance_records.status = ?", consts.RecordStatusFinished)
+
+	err := r.db.Preload("Asset").
+		Where("next_maintenance_date < ?", now).
+		Where("meter_id IS NULL").
+		Where("suspended_at IS NULL").
+		Where("NOT EXISTS (?)", finishedRecords).
+		Order("next_maintenance_date asc").
+		Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// GetMaintenanceSchedulesToResume returns suspended schedules whose
+// suspended-until date has been reached.
+func (r *maintenanceScheduleRepository) GetMaintenanceSchedulesToResume(now time.Time) ([]models.MaintenanceSchedule, error) {
+	var schedules []models.MaintenanceSchedule
+	err := r.db.Preload("Asset").
+		Where("suspended_at IS NOT NULL AND suspended_until <= ?", now).
+		Order("suspended_until asc").
+		Find(&schedules).Error
+	return schedules, err
+}
+
+func (r *maintenanceScheduleRepository) GetMaintenanceSchedulesByMeterID(meterID string) ([]models.MaintenanceSchedule, error) {
+	var schedules []models.MaintenanceSchedule
+	err := r.db.Preload("Asset").Where("meter_id = ?", meterID).Find(&schedules).Error
+	return schedules, err
+}
+
 func (r *maintenanceScheduleRepository) UpdateMaintenanceSchedule(schedule *models.MaintenanceSchedule) error {
 	return r.db.Save(schedule).Error
 }
 
+// DeleteMaintenanceSchedule deletes a schedule together with its checklist.
 func (r *maintenanceScheduleRepository) DeleteMaintenanceSchedule(scheduleID string) error {
-	return r.db.Delete(&models.MaintenanceSchedule{}, "id = ?", scheduleID).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("schedule_id = ?", scheduleID).Delete(&models.ChecklistTemplateItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.MaintenanceSchedule{}, "id = ?", scheduleID).Error
+	})
 }
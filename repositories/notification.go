@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository interface {
+	WithTx(tx *gorm.DB) NotificationRepository
+	CreateNotifications(notifications []models.Notification) error
+	GetNotificationsByUser(userID string, unreadOnly bool, page, itemsPerPage int) ([]models.Notification, int64, error)
+	CountUnreadNotifications(userID string) (int64, error)
+	MarkNotificationRead(userID, notificationID string, readAt time.Time) (bool, error)
+	MarkAllNotificationsRead(userID string, readAt time.Time) error
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) WithTx(tx *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: tx}
+}
+
+func (r *notificationRepository) CreateNotifications(notifications []models.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	return r.db.Omit("Actor").Create(&notifications).Error
+}
+
+func (r *notificationRepository) GetNotificationsByUser(userID string, unreadOnly bool, page, itemsPerPage int) ([]models.Notification, int64, error) {
+	var notifications []models.Notification
+	var totalItems int64
+
+	query := r.db.Model(&models.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Preload("Actor").Order("created_at desc")
+	if page > 0 && itemsPerPage > 0 {
+		query = query.Limit(itemsPerPage).Offset((page - 1) * itemsPerPage)
+	}
+
+	if err := query.Find(&notifications).Error; err != nil {
+		return nil, 0, err
+	}
+	return notifications, totalItems, nil
+}
+
+func (r *notificationRepository) CountUnreadNotifications(userID string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkNotificationRead marks one of the user's notifications as read. It
+// reports false when the user has no such notification.
+func (r *notificationRepository) MarkNotificationRead(userID, notificationID string, readAt time.Time) (bool, error) {
+	var notification models.Notification
+	err := r.db.Where("id = ? AND user_id = ?", notificationID, userID).First(&notification).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if notification.ReadAt != nil {
+		return true, nil
+	}
+	err = r.db.Model(&models.Notification{}).Where("id = ?", notificationID).
+		UpdateColumn("read_at", readAt).Error
+	return err == nil, err
+}
+
+func (r *notificationRepository) MarkAllNotificationsRead(userID string, readAt time.Time) error {
+	return r.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		UpdateColumn("read_at", readAt).Error
+}
@@ -0,0 +1,193 @@
+package repositories
+
+import (
+	"time"
+
+	"jaga/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PartRepository interface {
+	WithTx(tx *gorm.DB) PartRepository
+	CreatePart(part *models.Part) error
+	GetPartByID(partID string) (*models.Part, error)
+	GetParts(page, itemsPerPage int, search, categoryID string, visibleCategoryIDs []string) ([]models.Part, int64, error)
+	UpdatePart(part *models.Part) error
+	DeletePart(partID string) error
+	IsPartInUse(partID string) (bool, error)
+	SetPartStock(partID, locationID string, quantity float64) error
+	DeductStock(partID, locationID string, quantity float64) (bool, error)
+	RestoreStock(partID, locationID string, quantity float64) error
+	GetLowStockParts() ([]models.Part, error)
+	CreateRecordPart(recordPart *models.RecordPart) error
+	GetRecordParts(recordID string) ([]models.RecordPart, error)
+	GetRecordPartByID(recordPartID string) (*models.RecordPart, error)
+	SetRecordPartDeductedAt(recordPartID string, deductedAt *time.Time) error
+	DeleteRecordPart(recordPartID string) error
+}
+
+type partRepository struct {
+	db *gorm.DB
+}
+
+func NewPartRepository(db *gorm.DB) PartRepository {
+	return &partRepository{db: db}
+}
+
+func (r *partRepository) WithTx(tx *gorm.DB) PartRepository {
+	return &partRepository{db: tx}
+}
+
+func (r *partRepository) CreatePart(part *models.Part) error {
+	return r.db.Omit("Stocks").Create(part).Error
+}
+
+func (r *partRepository) GetPartByID(partID string) (*models.Part, error) {
+	var part models.Part
+	err := r.db.Preload("Categories").Preload("Stocks.Location").Where("id = ?", partID).First(&part).Error
+	if err != nil {
+		return nil, err
+	}
+	return &part, nil
+}
+
+// GetParts lists parts by name. A non-nil visibleCategoryIDs limits the list
+// to parts without categories and parts linked to one of those categories.
+func (r *partRepository) GetParts(page, itemsPerPage int, search, categoryID string, visibleCategoryIDs []string) ([]models.Part, int64, error) {
+	var parts []models.Part
+	var totalItems int64
+
+	query := r.db.Model(&models.Part{}).Preload("Categories").Preload("Stocks.Location")
+
+	if search != "" {
+		query = query.Where("name LIKE ? OR sku LIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+	if categoryID != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM part_categories pc WHERE pc.part_id = parts.id AND pc.category_id = ?)", categoryID)
+	}
+	if visibleCategoryIDs != nil {
+		generic := "NOT EXISTS (SELECT 1 FROM part_categories pc WHERE pc.part_id = parts.id)"
+		if len(visibleCategoryIDs) == 0 {
+			query = query.Where(generic)
+		} else {
+			query = query.Where(generic+" OR EXISTS (SELECT 1 FROM part_categories pc WHERE pc.part_id = parts.id AND pc.category_id IN (?))", visibleCategoryIDs)
+		}
+	}
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Order("name asc")
+	if page > 0 && itemsPerPage > 0 {
+		offset := (page - 1) * itemsPerPage
+		query = query.Limit(itemsPerPage).Offset(offset)
+	}
+
+	if err := query.Find(&parts).Error; err != nil {
+		return nil, 0, err
+	}
+	return parts, totalItems, nil
+}
+
+// UpdatePart saves a part and replaces its categories with part.Categories.
+func (r *partRepository) UpdatePart(part *models.Part) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Categories", "Stocks").Save(part).Error; err != nil {
+			return err
+		}
+		return tx.Model(part).Association("Categories").Replace(part.Categories)
+	})
+}
+
+func (r *partRepository) DeletePart(partID string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM part_categories WHERE part_id = ?", partID).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("part_id = ?", partID).Delete(&models.PartStock{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Part{}, "id = ?", partID).Error
+	})
+}
+
+func (r *partRepository) IsPartInUse(partID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.RecordPart{}).Where("part_id = ?", partID).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *partRepository) SetPartStock(partID, locationID string, quantity float64) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "part_id"}, {Name: "location_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"quantity", "updated_at"}),
+	}).Create(&models.PartStock{PartID: partID, LocationID: locationID, Quantity: quantity}).Error
+}
+
+// DeductStock takes quantity out of stock in a single conditional update, so
+// concurrent deductions cannot drive the stock negative. It reports false
+// when not enough is on hand.
+func (r *partRepository) DeductStock(partID, locationID string, quantity float64) (bool, error) {
+	result := r.db.Model(&models.PartStock{}).
+		Where("part_id = ? AND location_id = ? AND quantity >= ?", partID, locationID, quantity).
+		Updates(map[string]interface{}{
+			"quantity":   gorm.Expr("quantity - ?", quantity),
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *partRepository) RestoreStock(partID, locationID string, quantity float64) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "part_id"}, {Name: "location_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"quantity":   gorm.Expr("quantity + ?", quantity),
+			"updated_at": time.Now(),
+		}),
+	}).Create(&models.PartStock{PartID: partID, LocationID: locationID, Quantity: quantity}).Error
+}
+
+// GetLowStockParts returns the parts with a threshold whose total stock over
+// all locations is at or below it.
+func (r *partRepository) GetLowStockParts() ([]models.Part, error) {
+	var parts []models.Part
+	err := r.db.Preload("Stocks.Location").
+		Where("low_stock_threshold > 0 AND COALESCE((SELECT SUM(ps.quantity) FROM part_stocks ps WHERE ps.part_id = parts.id), 0) <= low_stock_threshold").
+		Order("name asc").
+		Find(&parts).Error
+	return parts, err
+}
+
+func (r *partRepository) CreateRecordPart(recordPart *models.RecordPart) error {
+	return r.db.Omit("Part", "Location").Create(recordPart).Error
+}
+
+func (r *partRepository) GetRecordParts(recordID string) ([]models.RecordPart, error) {
+	var recordParts []models.RecordPart
+	err := r.db.Preload("Part").Preload("Location").
+		Where("record_id = ?", recordID).
+		Order("created_at asc").
+		Find(&recordParts).Error
+	return recordParts, err
+}
+
+func (r *partRepository) GetRecordPartByID(recordPartID string) (*models.RecordPart, error) {
+	var recordPart models.RecordPart
+	err := r.db.Preload("Part").Preload("Location").Where("id = ?", recordPartID).First(&recordPart).Error
+	if err != nil {
+		return nil, err
+	}
+	return &recordPart, nil
+}
+
+func (r *partRepository) SetRecordPartDeductedAt(recordPartID string, deductedAt *time.Time) error {
+	return r.db.Model(&models.RecordPart{}).Where("id = ?", recordPartID).
+		UpdateColumn("deducted_at", deductedAt).Error
+}
+
+func (r *partRepository) DeleteRecordPart(recordPartID string) error {
+	return r.db.Delete(&models.RecordPart{}, "id = ?", recordPartID).Error
+}
@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type RecordCommentRepository interface {
+	WithTx(tx *gorm.DB) RecordCommentRepository
+	CreateRecordComment(comment *models.RecordComment) error
+	GetRecordCommentByID(commentID string) (*models.RecordComment, error)
+	GetRecordComments(recordID string) ([]models.RecordComment, error)
+	UpdateRecordCommentBody(comment *models.RecordComment) error
+	AddMentions(commentID string, userIDs []string) error
+}
+
+type recordCommentRepository struct {
+	db *gorm.DB
+}
+
+func NewRecordCommentRepository(db *gorm.DB) RecordCommentRepository {
+	return &recordCommentRepository{db: db}
+}
+
+func (r *recordCommentRepository) WithTx(tx *gorm.DB) RecordCommentRepository {
+	return &recordCommentRepository{db: tx}
+}
+
+func (r *recordCommentRepository) CreateRecordComment(comment *models.RecordComment) error {
+	return r.db.Omit("Author", "Mentions").Create(comment).Error
+}
+
+func (r *recordCommentRepository) GetRecordCommentByID(commentID string) (*models.RecordComment, error) {
+	var comment models.RecordComment
+	err := r.db.Preload("Author").Preload("Mentions.User").Where("id = ?", commentID).First(&comment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (r *recordCommentRepository) GetRecordComments(recordID string) ([]models.RecordComment, error) {
+	var comments []models.RecordComment
+	err := r.db.Preload("Author").Preload("Mentions.User").
+		Where("record_id = ?", recordID).
+		Order("created_at asc").
+		Find(&comments).Error
+	return comments, err
+}
+
+func (r *recordCommentRepository) UpdateRecordCommentBody(comment *models.RecordComment) error {
+	return r.db.Model(&models.RecordComment{}).Where("id = ?", comment.ID).
+		Updates(map[string]interface{}{
+			"body":      comment.Body,
+			"edited_at": comment.EditedAt,
+		}).Error
+}
+
+func (r *recordCommentRepository) AddMentions(commentID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	mentions := make([]models.RecordCommentMention, len(userIDs))
+	for i, userID := range userIDs {
+		mentions[i] = models.RecordCommentMention{CommentID: commentID, UserID: userID}
+	}
+	return r.db.Omit("User").Create(&mentions).Error
+}
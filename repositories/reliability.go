@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"time"
+
+	"jaga/consts"
+
+	"gorm.io/gorm"
+)
+
+// FailureEvent is a corrective maintenance record, one with a problem code.
+// Its repair starts at the first move to in_progress, or at the record's
+// creation when it was created in progress, and ends at its last move to
+// finished.
+type FailureEvent struct {
+	RecordID        string
+	AssetID         string
+	AssetName       string
+	CategoryID      string
+	MaintenanceDate time.Time
+	StartedAt       time.Time
+	FinishedAt      *time.Time
+}
+
+// AssetInService is an asset counted towards operating time from the day it
+// was purchased, or added when its purchase date is unknown.
+type AssetInService struct {
+	ID             string
+	Name           string
+	CategoryID     string
+	InServiceSince time.Time
+}
+
+type ReliabilityRepository interface {
+	GetFailureEvents(from, to time.Time, categoryID string) ([]FailureEvent, error)
+	GetAssetsInService(before time.Time, categoryID string) ([]AssetInService, error)
+}
+
+type reliabilityRepository struct {
+	db *gorm.DB
+}
+
+func NewReliabilityRepository(db *gorm.DB) ReliabilityRepository {
+	return &reliabilityRepository{db: db}
+}
+
+// GetFailureEvents lists the corrective records with a maintenance date in
+// [from, to). Cancelled records are left out.
+func (r *reliabilityRepository) GetFailureEvents(from, to time.Time, categoryID string) ([]FailureEvent, error) {
+	startedAt := r.db.Table("maintenance_record_status_changes sc").
+		Select("MIN(sc.changed_at)").
+		Where("sc.record_id = mr.id AND sc.to_status = ?", consts.RecordStatusInProgress)
+	finishedAt := r.db.Table("maintenance_record_status_changes sc").
+		Select("MAX(sc.changed_at)").
+		Where("sc.record_id = mr.id AND sc.to_status = ?", consts.RecordStatusFinished)
+
+	query := r.db.Table("maintenance_records mr").
+		Joins("JOIN assets a ON a.id = mr.asset_id").
+		Select("mr.id AS record_id, mr.asset_id, a.name AS asset_name, a.category_id, mr.maintenance_date, "+
+			"COALESCE((?), mr.created_at) AS started_at, (?) AS finished_at", startedAt, finishedAt).
+		Where("mr.problem_code_id IS NOT NULL AND mr.status <> ?", consts.RecordStatusCancelled).
+		Where("mr.maintenance_date >= ? AND mr.maintenance_date < ?", from, to)
+	if categoryID != "" {
+		query = query.Where("a.category_id = ?", categoryID)
+	}
+
+	var events []FailureEvent
+	err := query.Order("mr.maintenance_date asc").Scan(&events).Error
+	return events, err
+}
+
+// GetAssetsInService lists the assets already in service before the given
+// time.
+func (r *reliabilityRepository) GetAssetsInService(before time.Time, categoryID string) ([]AssetInService, error) {
+	query := r.db.Table("assets").
+		Select("id, name, category_id, COALESCE(purchase_date, created_at) AS in_service_since").
+		Where("COALESCE(purchase_date, created_at) < ?", before)
+	if categoryID != "" {
+		query = query.Where("category_id = ?", categoryID)
+	}
+
+	var assets []AssetInService
+	err := query.Order("name asc").Scan(&assets).Error
+	return assets, err
+}
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type SLARepository interface {
+	GetSLAPolicies() ([]models.SLAPolicy, error)
+	GetSLAPolicy(priority string) (*models.SLAPolicy, error)
+	SaveSLAPolicy(policy *models.SLAPolicy) error
+	DeleteSLAPolicy(priority string) error
+	MarkSLABreaches(now time.Time) (int64, error)
+	GetRecordsToEscalate(before time.Time) ([]models.MaintenanceRecord, error)
+	MarkSLAEscalated(recordID string, response, resolution bool, at time.Time) error
+}
+
+type slaRepository struct {
+	db *gorm.DB
+}
+
+func NewSLARepository(db *gorm.DB) SLARepository {
+	return &slaRepository{db: db}
+}
+
+func (r *slaRepository) GetSLAPolicies() ([]models.SLAPolicy, error) {
+	var policies []models.SLAPolicy
+	err := r.db.Order("FIELD(priority, 'critical', 'high', 'medium', 'low')").Find(&policies).Error
+	return policies, err
+}
+
+func (r *slaRepository) GetSLAPolicy(priority string) (*models.SLAPolicy, error) {
+	var policy models.SLAPolicy
+	err := r.db.Where("priority = ?", priority).First(&policy).Error
+	return &policy, err
+}
+
+func (r *slaRepository) SaveSLAPolicy(policy *models.SLAPolicy) error {
+	return r.db.Save(policy).Error
+}
+
+func (r *slaRepository) DeleteSLAPolicy(priority string) error {
+	return r.db.Where("priority = ?", priority).Delete(&models.SLAPolicy{}).Error
+}
+
+// MarkSLABreaches flags the open records that missed their response or
+// resolution deadline by now.
+func (r *slaRepository) MarkSLABreaches(now time.Time) (int64, error) {
+	result := r.db.Model(&models.MaintenanceRecord{}).
+		Where("status IN ? AND sla_breached = ?", consts.OpenMaintenanceRecordStatuses, false).
+		Where("(responded_at IS NULL AND response_due_at <= ?) OR resolution_due_at <= ?", now, now).
+		Update("sla_breached", true)
+	return result.RowsAffected, result.Error
+}
+
+// GetRecordsToEscalate lists the open records with a response or resolution
+// deadline before the given time that has not been escalated yet.
+func (r *slaRepository) GetRecordsToEscalate(before time.Time) ([]models.MaintenanceRecord, error) {
+	var records []models.MaintenanceRecord
+	err := r.db.Preload("Asset").
+		Where("status IN ?", consts.OpenMaintenanceRecordStatuses).
+		Where("(responded_at IS NULL AND response_escalated_at IS NULL AND response_due_at < ?) OR "+
+			"(resolution_escalated_at IS NULL AND resolution_due_at < ?)", before, before).
+		Order("COALESCE(response_due_at, resolution_due_at) asc").
+		Find(&records).Error
+	return records, err
+}
+
+func (r *slaRepository) MarkSLAEscalated(recordID string, response, resolution bool, at time.Time) error {
+	updates := map[string]interface{}{}
+	if response {
+		updates["response_escalated_at"] = at
+	}
+	if resolution {
+		updates["resolution_escalated_at"] = at
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	return r.db.Model(&models.MaintenanceRecord{}).
+		Where("id = ?", recordID).
+		Updates(updates).Error
+}
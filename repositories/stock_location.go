@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type StockLocationRepository interface {
+	CreateStockLocation(location *models.StockLocation) error
+	GetStockLocations() ([]models.StockLocation, error)
+	GetStockLocationByID(locationID string) (*models.StockLocation, error)
+	UpdateStockLocation(location *models.StockLocation) error
+	DeleteStockLocation(locationID string) error
+	IsStockLocationInUse(locationID string) (bool, error)
+}
+
+type stockLocationRepository struct {
+	db *gorm.DB
+}
+
+func NewStockLocationRepository(db *gorm.DB) StockLocationRepository {
+	return &stockLocationRepository{db: db}
+}
+
+func (r *stockLocationRepository) CreateStockLocation(location *models.StockLocation) error {
+	return r.db.Create(location).Error
+}
+
+func (r *stockLocationRepository) GetStockLocations() ([]models.StockLocation, error) {
+	var locations []models.StockLocation
+	err := r.db.Order("name asc").Find(&locations).Error
+	return locations, err
+}
+
+func (r *stockLocationRepository) GetStockLocationByID(locationID string) (*models.StockLocation, error) {
+	var location models.StockLocation
+	if err := r.db.Where("id = ?", locationID).First(&location).Error; err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+func (r *stockLocationRepository) UpdateStockLocation(location *models.StockLocation) error {
+	return r.db.Save(location).Error
+}
+
+// DeleteStockLocation deletes a location together with its empty stock rows.
+func (r *stockLocationRepository) DeleteStockLocation(locationID string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("location_id = ?", locationID).Delete(&models.PartStock{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.StockLocation{}, "id = ?", locationID).Error
+	})
+}
+
+// IsStockLocationInUse reports whether parts are still stocked at the
+// location or recorded against it.
+func (r *stockLocationRepository) IsStockLocationInUse(locationID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.PartStock{}).
+		Where("location_id = ? AND quantity > 0", locationID).
+		Count(&count).Error
+	if err != nil || count > 0 {
+		return count > 0, err
+	}
+
+	err = r.db.Model(&models.RecordPart{}).Where("location_id = ?", locationID).Count(&count).Error
+	return count > 0, err
+}
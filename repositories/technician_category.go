@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type TechnicianCategoryRepository interface {
+	GetTechnicianCategories(userID string) ([]models.TechnicianCategory, error)
+	GetAllTechnicianCategories() ([]models.TechnicianCategory, error)
+	ReplaceTechnicianCategories(userID string, categoryIDs []string) error
+}
+
+type technicianCategoryRepository struct {
+	db *gorm.DB
+}
+
+func NewTechnicianCategoryRepository(db *gorm.DB) TechnicianCategoryRepository {
+	return &technicianCategoryRepository{db: db}
+}
+
+func (r *technicianCategoryRepository) GetTechnicianCategories(userID string) ([]models.TechnicianCategory, error) {
+	var categories []models.TechnicianCategory
+	err := r.db.Preload("Category").Where("user_id = ?", userID).Find(&categories).Error
+	return categories, err
+}
+
+func (r *technicianCategoryRepository) GetAllTechnicianCategories() ([]models.TechnicianCategory, error) {
+	var categories []models.TechnicianCategory
+	err := r.db.Find(&categories).Error
+	return categories, err
+}
+
+// ReplaceTechnicianCategories sets the technician's categories to exactly
+// categoryIDs.
+func (r *technicianCategoryRepository) ReplaceTechnicianCategories(userID string, categoryIDs []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.TechnicianCategory{}).Error; err != nil {
+			return err
+		}
+		if len(categoryIDs) == 0 {
+			return nil
+		}
+
+		rows := make([]models.TechnicianCategory, len(categoryIDs))
+		for i, categoryID := range categoryIDs {
+			rows[i] = models.TechnicianCategory{UserID: userID, CategoryID: categoryID}
+		}
+		return tx.Create(&rows).Error
+	})
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import "gorm.io/gorm"
+
+// TxManager runs work that spans several repositories in one database
+// transaction. Repositories join it through their WithTx methods.
+type TxManager interface {
+	Transaction(fn func(tx *gorm.DB) error) error
+}
+
+type txManager struct {
+	db *gorm.DB
+}
+
+func NewTxManager(db *gorm.DB) TxManager {
+	return &txManager{db: db}
+}
+
+func (m *txManager) Transaction(fn func(tx *gorm.DB) error) error {
+	return m.db.Transaction(fn)
+}
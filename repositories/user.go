@@ -10,7 +10,9 @@ type UserRepository interface {
 	GetUsers(page, itemsPerPage int, sortBy, sortDir, search string) ([]models.User, int64, error)
 	GetUserByID(userID string) (*models.User, error)
 	GetUserByEmail(email string) (*models.User, error)
+	GetUsersByEmails(emails []string) ([]models.User, error)
 	GetUserByRole(role string) (*models.User, error)
+	GetUsersByRole(role string) ([]models.User, error)
 	CreateUser(user *models.User) error
 	UpdateUser(user *models.User) error
 	DeleteUser(userID string) error
@@ -77,6 +79,15 @@ func (r *userRepository) GetUserByEmail(email string) (*models.User, error) {
 	return &user, nil
 }
 
+func (r *userRepository) GetUsersByEmails(emails []string) ([]models.User, error) {
+	var users []models.User
+	if len(emails) == 0 {
+		return users, nil
+	}
+	err := r.db.Where("email IN (?)", emails).Find(&users).Error
+	return users, err
+}
+
 func (r *userRepository) GetUserByRole(role string) (*models.User, error) {
 	var user models.User
 
@@ -86,6 +97,12 @@ func (r *userRepository) GetUserByRole(role string) (*models.User, error) {
 	return &user, nil
 }
 
+func (r *userRepository) GetUsersByRole(role string) ([]models.User, error) {
+	var users []models.User
+	err := r.db.Where("role = ?", role).Order("name asc").Find(&users).Error
+	return users, err
+}
+
 func (r *userRepository) CreateUser(user *models.User) error {
 	if err := r.db.Create(user).Error; err != nil {
 		return err
@@ -100,7 +117,14 @@ func (r *userRepository) UpdateUser(user *models.User) error {
 	return nil
 }
 
+// DeleteUser deletes a user and detaches the users reporting to them.
 func (r *userRepository) DeleteUser(userID string) error {
+	err := r.db.Model(&models.User{}).
+		Where("manager_id = ?", userID).
+		Update("manager_id", nil).Error
+	if err != nil {
+		return err
+	}
 	if err := r.db.Delete(&models.User{}, "id = ?", userID).Error; err != nil {
 		return err
 	}
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type VendorInvoiceRepository interface {
+	CreateVendorInvoice(invoice *models.VendorInvoice) error
+	GetVendorInvoiceByID(invoiceID string) (*models.VendorInvoice, error)
+	GetVendorInvoicesByRecord(recordID string) ([]models.VendorInvoice, error)
+	DeleteVendorInvoice(invoiceID string) error
+}
+
+type vendorInvoiceRepository struct {
+	db *gorm.DB
+}
+
+func NewVendorInvoiceRepository(db *gorm.DB) VendorInvoiceRepository {
+	return &vendorInvoiceRepository{db: db}
+}
+
+func (r *vendorInvoiceRepository) CreateVendorInvoice(invoice *models.VendorInvoice) error {
+	return r.db.Create(invoice).Error
+}
+
+func (r *vendorInvoiceRepository) GetVendorInvoiceByID(invoiceID string) (*models.VendorInvoice, error) {
+	var invoice models.VendorInvoice
+	if err := r.db.Where("id = ?", invoiceID).First(&invoice).Error; err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+func (r *vendorInvoiceRepository) GetVendorInvoicesByRecord(recordID string) ([]models.VendorInvoice, error) {
+	var invoices []models.VendorInvoice
+	err := r.db.Where("record_id = ?", recordID).
+		Order("invoice_date asc").
+		Find(&invoices).Error
+	return invoices, err
+}
+
+func (r *vendorInvoiceRepository) DeleteVendorInvoice(invoiceID string) error {
+	return r.db.Delete(&models.VendorInvoice{}, "id = ?", invoiceID).Error
+}
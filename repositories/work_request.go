@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"jaga/models"
+
+	"gorm.io/gorm"
+)
+
+type WorkRequestRepository interface {
+	WithTx(tx *gorm.DB) WorkRequestRepository
+	CreateWorkRequest(request *models.WorkRequest) error
+	GetWorkRequestByID(requestID string) (*models.WorkRequest, error)
+	GetWorkRequests(page, itemsPerPage int, status, urgency, assetID, requestedBy string) ([]models.WorkRequest, int64, error)
+	UpdateWorkRequestStatus(request *models.WorkRequest, fromStatus string) (bool, error)
+}
+
+type workRequestRepository struct {
+	db *gorm.DB
+}
+
+func NewWorkRequestRepository(db *gorm.DB) WorkRequestRepository {
+	return &workRequestRepository{db: db}
+}
+
+func (r *workRequestRepository) WithTx(tx *gorm.DB) WorkRequestRepository {
+	return &workRequestRepository{db: tx}
+}
+
+func (r *workRequestRepository) CreateWorkRequest(request *models.WorkRequest) error {
+	return r.db.Omit("Asset", "Requester", "Record").Create(request).Error
+}
+
+func (r *workRequestRepository) GetWorkRequestByID(requestID string) (*models.WorkRequest, error) {
+	var request models.WorkRequest
+	err := r.db.Preload("Asset").Preload("Requester").Preload("Record").
+		Where("id = ?", requestID).
+		First(&request).Error
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *workRequestRepository) GetWorkRequests(page, itemsPerPage int, status, urgency, assetID, requestedBy string) ([]models.WorkRequest, int64, error) {
+	var requests []models.WorkRequest
+	var totalItems int64
+
+	query := r.db.Model(&models.WorkRequest{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if urgency != "" {
+		query = query.Where("urgency = ?", urgency)
+	}
+	if assetID != "" {
+		query = query.Where("asset_id = ?", assetID)
+	}
+	if requestedBy != "" {
+		query = query.Where("requested_by = ?", requestedBy)
+	}
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Preload("Asset").Preload("Requester").Preload("Record").
+		Order("FIELD(urgency, 'critical', 'high', 'medium', 'low'), created_at asc")
+	if page > 0 && itemsPerPage > 0 {
+		query = query.Limit(itemsPerPage).Offset((page - 1) * itemsPerPage)
+	}
+
+	if err := query.Find(&requests).Error; err != nil {
+		return nil, 0, err
+	}
+	return requests, totalItems, nil
+}
+
+// UpdateWorkRequestStatus saves a triage decision, provided the request is
+// still in fromStatus. It reports false when another decision got there
+// first.
+func (r *workRequestRepository) UpdateWorkRequestStatus(request *models.WorkRequest, fromStatus string) (bool, error) {
+	result := r.db.Model(&models.WorkRequest{}).
+		Where("id = ? AND status = ?", request.ID, fromStatus).
+		Updates(map[string]interface{}{
+			"status":         request.Status,
+			"merged_into_id": request.MergedIntoID,
+			"feedback":       request.Feedback,
+			"triaged_by":     request.TriagedBy,
+			"triaged_at":     request.TriagedAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
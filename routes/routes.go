@@ -15,6 +15,7 @@ import (
 
 func RegisterRoutes() *gin.Engine {
 	r := gin.Default()
+	registerValidators()
 
 	userRepositories := repositories.NewUserRepository(config.DB)
 	userService := services.NewUserService(userRepositories)
@@ -30,20 +31,105 @@ func RegisterRoutes() *gin.Engine {
 	assetService := services.NewAssetService(assetRepository, assetCategoryRepository)
 	assetController := controllers.NewAssetController(assetService)
 
+	assetMeterRepository := repositories.NewAssetMeterRepository(config.DB)
+
+	maintenanceRecordRepository := repositories.NewMaintenanceRecordRepository(config.DB)
+
+	holidayCalendarRepository := repositories.NewHolidayCalendarRepository(config.DB)
+	holidayCalendarService := services.NewHolidayCalendarService(holidayCalendarRepository)
+	holidayCalendarController := controllers.NewHolidayCalendarController(holidayCalendarService)
+
+	technicianCategoryRepository := repositories.NewTechnicianCategoryRepository(config.DB)
+	assignmentService := services.NewAssignmentService(userRepositories, maintenanceRecordRepository, assetCategoryRepository, technicianCategoryRepository, repositories.NewTxManager(config.DB))
+	assignmentController := controllers.NewAssignmentController(assignmentService)
+
 	maintenanceScheduleRepository := repositories.NewMaintenanceScheduleRepository(config.DB)
-	maintenanceScheduleService := services.NewMaintenanceScheduleService(maintenanceScheduleRepository, assetRepository)
+	maintenanceScheduleService := services.NewMaintenanceScheduleService(maintenanceScheduleRepository, assetRepository, assetMeterRepository, userRepositories, holidayCalendarRepository, assignmentService)
 	maintenanceScheduleController := controllers.NewMaintenanceScheduleController(maintenanceScheduleService)
 
-	maintenanceRecordRepository := repositories.NewMaintenanceRecordRepository(config.DB)
-	maintenanceRecordService := services.NewMaintenanceRecordService(maintenanceRecordRepository, assetRepository, maintenanceScheduleRepository, userRepositories)
+	checklistRepository := repositories.NewChecklistRepository(config.DB)
+	checklistService := services.NewChecklistService(checklistRepository, maintenanceScheduleRepository, assetCategoryRepository, maintenanceRecordRepository)
+	checklistController := controllers.NewChecklistController(checklistService)
+
+	stockLocationRepository := repositories.NewStockLocationRepository(config.DB)
+	stockLocationService := services.NewStockLocationService(stockLocationRepository)
+	stockLocationController := controllers.NewStockLocationController(stockLocationService)
+
+	partRepository := repositories.NewPartRepository(config.DB)
+	partService := services.NewPartService(partRepository, stockLocationRepository, assetCategoryRepository, maintenanceRecordRepository)
+	partController := controllers.NewPartController(partService)
+
+	laborEntryRepository := repositories.NewLaborEntryRepository(config.DB)
+	laborService := services.NewLaborService(laborEntryRepository, maintenanceRecordRepository, userRepositories)
+	laborController := controllers.NewLaborController(laborService)
+
+	costService := services.NewCostService(repositories.NewCostRepository(config.DB), repositories.NewVendorInvoiceRepository(config.DB), maintenanceRecordRepository, assetRepository, assetCategoryRepository, laborEntryRepository, partRepository)
+	costController := controllers.NewCostController(costService)
+
+	notificationRepository := repositories.NewNotificationRepository(config.DB)
+	notificationService := services.NewNotificationService(notificationRepository)
+	notificationController := controllers.NewNotificationController(notificationService)
+
+	recordCommentService := services.NewRecordCommentService(repositories.NewRecordCommentRepository(config.DB), maintenanceRecordRepository, userRepositories, notificationRepository, repositories.NewTxManager(config.DB), config.CommentEditWindow)
+	recordCommentController := controllers.NewRecordCommentController(recordCommentService)
+
+	failureCodeService := services.NewFailureCodeService(repositories.NewFailureCodeRepository(config.DB), maintenanceRecordRepository)
+	failureCodeController := controllers.NewFailureCodeController(failureCodeService)
+
+	reliabilityService := services.NewReliabilityService(repositories.NewReliabilityRepository(config.DB), assetCategoryRepository)
+	reliabilityController := controllers.NewReliabilityController(reliabilityService)
+
+	approvalRepository := repositories.NewApprovalRepository(config.DB)
+	slaRepository := repositories.NewSLARepository(config.DB)
+	slaPolicyService := services.NewSLAPolicyService(slaRepository)
+	slaPolicyController := controllers.NewSLAPolicyController(slaPolicyService)
+
+	recordStatusMachine := services.NewRecordStatusMachine(config.RecordStatusTransitions)
+	maintenanceRecordService := services.NewMaintenanceRecordService(maintenanceRecordRepository, assetRepository, maintenanceScheduleRepository, userRepositories, checklistRepository, partRepository, laborEntryRepository, approvalRepository, slaRepository, notificationRepository, recordStatusMachine, repositories.NewTxManager(config.DB))
 	maintenanceRecordController := controllers.NewMaintenanceRecordController(maintenanceRecordService)
 
+	approvalService := services.NewApprovalService(approvalRepository, assetCategoryRepository, maintenanceRecordRepository)
+	approvalController := controllers.NewApprovalController(approvalService, maintenanceRecordService)
+
+	workRequestRepository := repositories.NewWorkRequestRepository(config.DB)
+	workRequestService := services.NewWorkRequestService(workRequestRepository, assetRepository, notificationRepository, maintenanceRecordService, repositories.NewTxManager(config.DB))
+	workRequestController := controllers.NewWorkRequestController(workRequestService)
+
+	maintenanceCalendarService := services.NewMaintenanceCalendarService(maintenanceScheduleRepository, maintenanceRecordRepository, holidayCalendarRepository)
+	maintenanceCalendarController := controllers.NewMaintenanceCalendarController(maintenanceCalendarService)
+
+	assetMeterService := services.NewAssetMeterService(assetMeterRepository, assetRepository, maintenanceScheduleRepository, maintenanceRecordRepository, maintenanceRecordService, assignmentService, repositories.NewTxManager(config.DB))
+	assetMeterController := controllers.NewAssetMeterController(assetMeterService)
+
+	attachmentService := services.NewAttachmentService(repositories.NewAttachmentRepository(config.DB), assetRepository, maintenanceRecordRepository, workRequestRepository, config.BlobStore, config.AttachmentMaxBytes)
+	attachmentController := controllers.NewAttachmentController(attachmentService, config.AttachmentMaxBytes)
+
+	calendarFeedTokenRepository := repositories.NewCalendarFeedTokenRepository(config.DB)
+	calendarFeedService := services.NewCalendarFeedService(calendarFeedTokenRepository, maintenanceScheduleRepository, maintenanceRecordRepository, holidayCalendarRepository)
+	calendarFeedController := controllers.NewCalendarFeedController(calendarFeedService)
+
 	r.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	v1 := r.Group("/v1")
 	{
 		v1.POST("/login", authController.Login)
 
+		meRoutes := v1.Group("/me")
+		{
+			meRoutes.POST("/calendar-feed-tokens", middleware.RequireRole(consts.AllRoles...), calendarFeedController.CreateCalendarFeedToken)
+			meRoutes.GET("/calendar-feed-tokens", middleware.RequireRole(consts.AllRoles...), calendarFeedController.GetCalendarFeedTokens)
+			meRoutes.DELETE("/calendar-feed-tokens/:id", middleware.RequireRole(consts.AllRoles...), calendarFeedController.RevokeCalendarFeedToken)
+			meRoutes.GET("/maintenance-records", middleware.RequireRole(consts.AllRoles...), maintenanceRecordController.GetMyMaintenanceRecords)
+			meRoutes.GET("/maintenance-schedules", middleware.RequireRole(consts.AllRoles...), maintenanceScheduleController.GetMyMaintenanceSchedules)
+			meRoutes.GET("/notifications", middleware.RequireRole(consts.AllRoles...), notificationController.GetMyNotifications)
+			meRoutes.POST("/notifications/read-all", middleware.RequireRole(consts.AllRoles...), notificationController.MarkAllNotificationsRead)
+			meRoutes.POST("/notifications/:id/read", middleware.RequireRole(consts.AllRoles...), notificationController.MarkNotificationRead)
+		}
+
+		// Calendar apps cannot send a JWT, so the feed is authenticated by the
+		// token in its URL.
+		v1.GET("/calendar-feeds/:token/maintenance.ics", calendarFeedController.GetCalendarFeed)
+
 		userRoutes := v1.Group("/users")
 		{
 			userRoutes.POST("", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), userController.CreateUser)
@@ -51,6 +137,8 @@ func RegisterRoutes() *gin.Engine {
 			userRoutes.GET("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), userController.GetUserByID)
 			userRoutes.PUT("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), userController.UpdateUser)
 			userRoutes.DELETE("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), userController.DeleteUser)
+			userRoutes.GET("/:id/categories", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), assignmentController.GetTechnicianCategories)
+			userRoutes.PUT("/:id/categories", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), assignmentController.SetTechnicianCategories)
 		}
 
 		assetCategoryRoutes := v1.Group("/asset-categories")
@@ -60,6 +148,10 @@ func RegisterRoutes() *gin.Engine {
 			assetCategoryRoutes.GET("/:id", middleware.RequireRole(consts.AllRoles...), assetCategoryController.GetAssetCategoryByID)
 			assetCategoryRoutes.PUT("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), assetCategoryController.UpdateAssetCategory)
 			assetCategoryRoutes.DELETE("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), assetCategoryController.DeleteAssetCategory)
+			assetCategoryRoutes.GET("/:id/checklist", middleware.RequireRole(consts.AllRoles...), checklistController.GetCategoryChecklist)
+			assetCategoryRoutes.PUT("/:id/checklist", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), checklistController.SetCategoryChecklist)
+			assetCategoryRoutes.GET("/:id/approval-rule", middleware.RequireRole(consts.AllRoles...), approvalController.GetCategoryApprovalRule)
+			assetCategoryRoutes.PUT("/:id/approval-rule", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), approvalController.SetCategoryApprovalRule)
 		}
 
 		assetRoutes := v1.Group("/assets")
@@ -69,24 +161,140 @@ func RegisterRoutes() *gin.Engine {
 			assetRoutes.GET("/:id", middleware.RequireRole(consts.AllRoles...), assetController.GetAssetByID)
 			assetRoutes.PUT("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), assetController.UpdateAsset)
 			assetRoutes.DELETE("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), assetController.DeleteAsset)
+			assetRoutes.POST("/:id/meters", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), assetMeterController.CreateAssetMeter)
+			assetRoutes.GET("/:id/meters", middleware.RequireRole(consts.AllRoles...), assetMeterController.GetAssetMeters)
+			assetRoutes.POST("/:id/meters/:meterId/readings", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), assetMeterController.CreateMeterReading)
+			assetRoutes.GET("/:id/meters/:meterId/readings", middleware.RequireRole(consts.AllRoles...), assetMeterController.GetMeterReadings)
+			assetRoutes.POST("/:id/attachments", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), attachmentController.UploadAssetAttachment)
+			assetRoutes.GET("/:id/attachments", middleware.RequireRole(consts.AllRoles...), attachmentController.GetAssetAttachments)
+			assetRoutes.GET("/:id/cost-summary", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleManager), costController.GetAssetCostSummary)
 		}
 
 		maintenanceScheduleRoutes := v1.Group("/maintenance-schedules")
 		{
 			maintenanceScheduleRoutes.POST("", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), maintenanceScheduleController.CreateMaintenanceSchedule)
 			maintenanceScheduleRoutes.GET("", middleware.RequireRole(consts.AllRoles...), maintenanceScheduleController.GetMaintenanceSchedules)
+			maintenanceScheduleRoutes.GET("/overdue", middleware.RequireRole(consts.AllRoles...), maintenanceScheduleController.GetOverdueMaintenanceSchedules)
+			maintenanceScheduleRoutes.POST("/preview-occurrences", middleware.RequireRole(consts.AllRoles...), maintenanceScheduleController.PreviewRecurrenceRule)
 			maintenanceScheduleRoutes.GET("/:id", middleware.RequireRole(consts.AllRoles...), maintenanceScheduleController.GetMaintenanceScheduleByID)
+			maintenanceScheduleRoutes.GET("/:id/occurrences", middleware.RequireRole(consts.AllRoles...), maintenanceScheduleController.GetUpcomingOccurrences)
 			maintenanceScheduleRoutes.PUT("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), maintenanceScheduleController.UpdateMaintenanceSchedule)
+			maintenanceScheduleRoutes.POST("/:id/suspend", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), maintenanceScheduleController.SuspendMaintenanceSchedule)
+			maintenanceScheduleRoutes.POST("/:id/resume", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), maintenanceScheduleController.ResumeMaintenanceSchedule)
+			maintenanceScheduleRoutes.GET("/:id/checklist", middleware.RequireRole(consts.AllRoles...), checklistController.GetScheduleChecklist)
+			maintenanceScheduleRoutes.PUT("/:id/checklist", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), checklistController.SetScheduleChecklist)
 			maintenanceScheduleRoutes.DELETE("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), maintenanceScheduleController.DeleteMaintenanceSchedule)
 		}
 
+		holidayCalendarRoutes := v1.Group("/holiday-calendars")
+		{
+			holidayCalendarRoutes.POST("", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), holidayCalendarController.CreateHolidayCalendar)
+			holidayCalendarRoutes.GET("", middleware.RequireRole(consts.AllRoles...), holidayCalendarController.GetHolidayCalendars)
+			holidayCalendarRoutes.GET("/:id", middleware.RequireRole(consts.AllRoles...), holidayCalendarController.GetHolidayCalendarByID)
+			holidayCalendarRoutes.PUT("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), holidayCalendarController.UpdateHolidayCalendar)
+			holidayCalendarRoutes.DELETE("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), holidayCalendarController.DeleteHolidayCalendar)
+			holidayCalendarRoutes.GET("/:id/holidays", middleware.RequireRole(consts.AllRoles...), holidayCalendarController.GetHolidays)
+			holidayCalendarRoutes.POST("/:id/holidays", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), holidayCalendarController.CreateHoliday)
+			holidayCalendarRoutes.DELETE("/:id/holidays/:holidayId", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), holidayCalendarController.DeleteHoliday)
+			holidayCalendarRoutes.POST("/:id/import", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), holidayCalendarController.ImportHolidays)
+		}
+
+		stockLocationRoutes := v1.Group("/stock-locations")
+		{
+			stockLocationRoutes.POST("", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), stockLocationController.CreateStockLocation)
+			stockLocationRoutes.GET("", middleware.RequireRole(consts.AllRoles...), stockLocationController.GetStockLocations)
+			stockLocationRoutes.GET("/:id", middleware.RequireRole(consts.AllRoles...), stockLocationController.GetStockLocationByID)
+			stockLocationRoutes.PUT("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), stockLocationController.UpdateStockLocation)
+			stockLocationRoutes.DELETE("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), stockLocationController.DeleteStockLocation)
+		}
+
+		failureCodeRoutes := v1.Group("/failure-codes")
+		{
+			failureCodeRoutes.POST("", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), failureCodeController.CreateFailureCode)
+			failureCodeRoutes.GET("", middleware.RequireRole(consts.AllRoles...), failureCodeController.GetFailureCodes)
+			failureCodeRoutes.GET("/:id", middleware.RequireRole(consts.AllRoles...), failureCodeController.GetFailureCodeByID)
+			failureCodeRoutes.PUT("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), failureCodeController.UpdateFailureCode)
+			failureCodeRoutes.DELETE("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), failureCodeController.DeleteFailureCode)
+		}
+
+		slaPolicyRoutes := v1.Group("/sla-policies")
+		{
+			slaPolicyRoutes.GET("", middleware.RequireRole(consts.AllRoles...), slaPolicyController.GetSLAPolicies)
+			slaPolicyRoutes.PUT("/:priority", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), slaPolicyController.SetSLAPolicy)
+			slaPolicyRoutes.DELETE("/:priority", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), slaPolicyController.DeleteSLAPolicy)
+		}
+
+		partRoutes := v1.Group("/parts")
+		{
+			partRoutes.POST("", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), partController.CreatePart)
+			partRoutes.GET("", middleware.RequireRole(consts.AllRoles...), partController.GetParts)
+			partRoutes.GET("/low-stock", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleManager), partController.GetLowStockParts)
+			partRoutes.GET("/:id", middleware.RequireRole(consts.AllRoles...), partController.GetPartByID)
+			partRoutes.PUT("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), partController.UpdatePart)
+			partRoutes.PUT("/:id/stock/:locationId", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), partController.SetPartStock)
+			partRoutes.DELETE("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), partController.DeletePart)
+		}
+
+		attachmentRoutes := v1.Group("/attachments")
+		{
+			attachmentRoutes.GET("/:id/download", middleware.RequireRole(consts.AllRoles...), attachmentController.DownloadAttachment)
+			attachmentRoutes.GET("/:id/thumbnail", middleware.RequireRole(consts.AllRoles...), attachmentController.GetAttachmentThumbnail)
+			attachmentRoutes.DELETE("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), attachmentController.DeleteAttachment)
+		}
+
+		reportRoutes := v1.Group("/reports")
+		{
+			reportRoutes.GET("/labor-hours", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleManager), laborController.GetLaborHoursReport)
+			reportRoutes.GET("/category-costs", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleManager), costController.GetCategoryCostRollup)
+			reportRoutes.GET("/reliability", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleManager), reliabilityController.GetReliabilityReport)
+		}
+
+		v1.GET("/maintenance-calendar", middleware.RequireRole(consts.AllRoles...), maintenanceCalendarController.GetMaintenanceCalendar)
+
+		workRequestRoutes := v1.Group("/work-requests")
+		{
+			workRequestRoutes.POST("", middleware.RequireRole(consts.AllRoles...), workRequestController.CreateWorkRequest)
+			workRequestRoutes.GET("", middleware.RequireRole(consts.AllRoles...), workRequestController.GetWorkRequests)
+			workRequestRoutes.GET("/:id", middleware.RequireRole(consts.AllRoles...), workRequestController.GetWorkRequestByID)
+			workRequestRoutes.POST("/:id/triage", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), workRequestController.TriageWorkRequest)
+			workRequestRoutes.POST("/:id/merge", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), workRequestController.MergeWorkRequest)
+			workRequestRoutes.POST("/:id/reject", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), workRequestController.RejectWorkRequest)
+			workRequestRoutes.POST("/:id/attachments", middleware.RequireRole(consts.AllRoles...), attachmentController.UploadWorkRequestAttachment)
+			workRequestRoutes.GET("/:id/attachments", middleware.RequireRole(consts.AllRoles...), attachmentController.GetWorkRequestAttachments)
+		}
+
 		maintenanceRecordRoutes := v1.Group("/maintenance-records")
 		{
 			maintenanceRecordRoutes.POST("", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), maintenanceRecordController.CreateMaintenanceRecord)
 			maintenanceRecordRoutes.GET("", middleware.RequireRole(consts.AllRoles...), maintenanceRecordController.GetMaintenanceRecords)
+			maintenanceRecordRoutes.POST("/rebalance", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), assignmentController.RebalanceMaintenanceRecords)
 			maintenanceRecordRoutes.GET("/:id", middleware.RequireRole(consts.AllRoles...), maintenanceRecordController.GetMaintenanceRecordByID)
 			maintenanceRecordRoutes.PUT("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), maintenanceRecordController.UpdateMaintenanceRecord)
 			maintenanceRecordRoutes.PUT("/:id/status", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), maintenanceRecordController.UpdateMaintenanceRecordStatus)
+			maintenanceRecordRoutes.GET("/:id/history", middleware.RequireRole(consts.AllRoles...), maintenanceRecordController.GetMaintenanceRecordHistory)
+			maintenanceRecordRoutes.PUT("/:id/failure-codes", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), failureCodeController.SetRecordFailureCodes)
+			maintenanceRecordRoutes.GET("/:id/approvals", middleware.RequireRole(consts.AllRoles...), approvalController.GetRecordApprovals)
+			maintenanceRecordRoutes.POST("/:id/approve", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleManager), approvalController.ApproveMaintenanceRecord)
+			maintenanceRecordRoutes.POST("/:id/reject", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleManager), approvalController.RejectMaintenanceRecord)
+			maintenanceRecordRoutes.GET("/:id/checklist", middleware.RequireRole(consts.AllRoles...), checklistController.GetRecordChecklist)
+			maintenanceRecordRoutes.PUT("/:id/checklist/:itemId", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), checklistController.UpdateRecordChecklistItem)
+			maintenanceRecordRoutes.POST("/:id/attachments", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), attachmentController.UploadMaintenanceRecordAttachment)
+			maintenanceRecordRoutes.GET("/:id/attachments", middleware.RequireRole(consts.AllRoles...), attachmentController.GetMaintenanceRecordAttachments)
+			maintenanceRecordRoutes.GET("/:id/parts", middleware.RequireRole(consts.AllRoles...), partController.GetRecordParts)
+			maintenanceRecordRoutes.POST("/:id/parts", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), partController.AddRecordPart)
+			maintenanceRecordRoutes.DELETE("/:id/parts/:partUsageId", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), partController.RemoveRecordPart)
+			maintenanceRecordRoutes.GET("/:id/labor", middleware.RequireRole(consts.AllRoles...), laborController.GetLaborEntries)
+			maintenanceRecordRoutes.POST("/:id/labor", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), laborController.LogLaborEntry)
+			maintenanceRecordRoutes.POST("/:id/labor/start", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), laborController.StartTimer)
+			maintenanceRecordRoutes.POST("/:id/labor/stop", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), laborController.StopTimer)
+			maintenanceRecordRoutes.DELETE("/:id/labor/:entryId", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleTechnician), laborController.DeleteLaborEntry)
+			maintenanceRecordRoutes.GET("/:id/costs", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleManager), costController.GetRecordCosts)
+			maintenanceRecordRoutes.GET("/:id/vendor-invoices", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin, consts.RoleManager), costController.GetVendorInvoices)
+			maintenanceRecordRoutes.POST("/:id/vendor-invoices", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), costController.CreateVendorInvoice)
+			maintenanceRecordRoutes.DELETE("/:id/vendor-invoices/:invoiceId", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), costController.DeleteVendorInvoice)
+			maintenanceRecordRoutes.GET("/:id/comments", middleware.RequireRole(consts.AllRoles...), recordCommentController.GetRecordComments)
+			maintenanceRecordRoutes.POST("/:id/comments", middleware.RequireRole(consts.AllRoles...), recordCommentController.CreateRecordComment)
+			maintenanceRecordRoutes.PUT("/:id/comments/:commentId", middleware.RequireRole(consts.AllRoles...), recordCommentController.UpdateRecordComment)
 			maintenanceRecordRoutes.DELETE("/:id", middleware.RequireRole(consts.RoleSuperUser, consts.RoleAdmin), maintenanceRecordController.DeleteMaintenanceRecord)
 		}
 	}
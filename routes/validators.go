@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"jaga/utils"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// registerValidators adds the custom binding tags used by the request DTOs.
+func registerValidators() {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+
+	v.RegisterValidation("rrule", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		if value == "" {
+			return true
+		}
+		_, err := utils.ParseRRule(value)
+		return err == nil
+	})
+}
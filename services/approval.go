@@ -0,0 +1,88 @@
+package services
+
+import (
+	"errors"
+
+	"jaga/models"
+	"jaga/repositories"
+
+	"gorm.io/gorm"
+)
+
+type ApprovalService interface {
+	GetCategoryApprovalRule(categoryID string) (*models.CategoryApprovalRule, error)
+	SetCategoryApprovalRule(rule *models.CategoryApprovalRule) error
+	GetRecordApprovals(recordID string) ([]models.RecordApproval, error)
+}
+
+type approvalService struct {
+	repo         repositories.ApprovalRepository
+	categoryRepo repositories.AssetCategoryRepository
+	recordRepo   repositories.MaintenanceRecordRepository
+}
+
+func NewApprovalService(
+	repo repositories.ApprovalRepository,
+	categoryRepo repositories.AssetCategoryRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+) ApprovalService {
+	return &approvalService{
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		recordRepo:   recordRepo,
+	}
+}
+
+// GetCategoryApprovalRule returns a category's approval rule. Categories
+// without one need no sign-off.
+func (s *approvalService) GetCategoryApprovalRule(categoryID string) (*models.CategoryApprovalRule, error) {
+	if err := s.findCategory(categoryID); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.repo.GetCategoryApprovalRule(categoryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &models.CategoryApprovalRule{CategoryID: categoryID}, nil
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+// SetCategoryApprovalRule replaces a category's approval rule. Records already
+// awaiting approval still need it.
+func (s *approvalService) SetCategoryApprovalRule(rule *models.CategoryApprovalRule) error {
+	if err := s.findCategory(rule.CategoryID); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetCategoryApprovalRule(rule.CategoryID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil {
+		rule.CreatedAt = existing.CreatedAt
+	}
+	return s.repo.SaveCategoryApprovalRule(rule)
+}
+
+func (s *approvalService) GetRecordApprovals(recordID string) ([]models.RecordApproval, error) {
+	if _, err := s.recordRepo.GetMaintenanceRecordByID(recordID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("maintenance record not found")
+		}
+		return nil, err
+	}
+	return s.repo.GetRecordApprovals(recordID)
+}
+
+func (s *approvalService) findCategory(categoryID string) error {
+	if _, err := s.categoryRepo.GetAssetCategoryByID(categoryID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("asset category not found")
+		}
+		return err
+	}
+	return nil
+}
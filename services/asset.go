@@ -2,6 +2,7 @@ package services
 
 import (
 	"errors"
+	"time"
 
 	"jaga/models"
 	"jaga/repositories"
@@ -15,7 +16,7 @@ type AssetService interface {
 	GetAssetByID(assetID string) (*models.Asset, error)
 	GetAssets(page, itemsPerPage int, sortBy, sortDir, search, categoryID, status string) ([]models.Asset, int64, error)
 	UpdateAsset(asset *models.Asset) error
-	UpdateAssetStatus(assetID, status string) error
+	UpdateAssetStatus(assetID, status, reason string) error
 	DeleteAsset(assetID string) error
 }
 
@@ -69,7 +70,7 @@ func (s *assetService) GetAssets(page, itemsPerPage int, sortBy, sortDir, search
 
 func (s *assetService) UpdateAsset(asset *models.Asset) error {
 
-	_, err := s.assetRepo.GetAssetByID(asset.ID)
+	existingAsset, err := s.assetRepo.GetAssetByID(asset.ID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return errors.New("asset not found")
@@ -77,6 +78,19 @@ func (s *assetService) UpdateAsset(asset *models.Asset) error {
 		return err
 	}
 
+	if asset.PurchaseCost == nil {
+		asset.PurchaseCost = existingAsset.PurchaseCost
+	}
+	// The reason only changes with the status; a status edited here is a
+	// manual change without one.
+	if asset.Status == existingAsset.Status {
+		asset.StatusReason = existingAsset.StatusReason
+		asset.StatusChangedAt = existingAsset.StatusChangedAt
+	} else {
+		now := time.Now()
+		asset.StatusChangedAt = &now
+	}
+
 	if asset.CategoryID != "" {
 		_, err := s.categoryRepo.GetAssetCategoryByID(asset.CategoryID)
 		if err != nil {
@@ -90,7 +104,7 @@ func (s *assetService) UpdateAsset(asset *models.Asset) error {
 	return s.assetRepo.UpdateAsset(asset)
 }
 
-func (s *assetService) UpdateAssetStatus(assetID, status string) error {
+func (s *assetService) UpdateAssetStatus(assetID, status, reason string) error {
 
 	_, err := s.assetRepo.GetAssetByID(assetID)
 	if err != nil {
@@ -100,7 +114,7 @@ func (s *assetService) UpdateAssetStatus(assetID, status string) error {
 		return err
 	}
 
-	return s.assetRepo.UpdateAssetStatus(assetID, status)
+	return s.assetRepo.UpdateAssetStatus(assetID, status, reason)
 }
 
 func (s *assetService) DeleteAsset(assetID string) error {
@@ -0,0 +1,232 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+type AssetMeterService interface {
+	CreateAssetMeter(meter *models.AssetMeter) error
+	GetAssetMeters(assetID string) ([]models.AssetMeter, error)
+	GetAssetMeterByID(assetID, meterID string) (*models.AssetMeter, error)
+	RecordMeterReading(assetID string, reading *models.MeterReading) (int, error)
+	GetMeterReadings(assetID, meterID string, page, itemsPerPage int) ([]models.MeterReading, int64, error)
+}
+
+type assetMeterService struct {
+	repo          repositories.AssetMeterRepository
+	assetRepo     repositories.AssetRepository
+	scheduleRepo  repositories.MaintenanceScheduleRepository
+	recordRepo    repositories.MaintenanceRecordRepository
+	recordService MaintenanceRecordService
+	assigner      AssignmentService
+	txManager     repositories.TxManager
+}
+
+func NewAssetMeterService(
+	repo repositories.AssetMeterRepository,
+	assetRepo repositories.AssetRepository,
+	scheduleRepo repositories.MaintenanceScheduleRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+	recordService MaintenanceRecordService,
+	assigner AssignmentService,
+	txManager repositories.TxManager,
+) AssetMeterService {
+	return &assetMeterService{
+		repo:          repo,
+		assetRepo:     assetRepo,
+		scheduleRepo:  scheduleRepo,
+		recordRepo:    recordRepo,
+		recordService: recordService,
+		assigner:      assigner,
+		txManager:     txManager,
+	}
+}
+
+func (s *assetMeterService) CreateAssetMeter(meter *models.AssetMeter) error {
+	_, err := s.assetRepo.GetAssetByID(meter.AssetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("asset not found")
+		}
+		return err
+	}
+
+	if meter.ID == "" {
+		meter.ID = utils.GenerateUUID()
+	}
+	return s.repo.CreateAssetMeter(meter)
+}
+
+func (s *assetMeterService) GetAssetMeters(assetID string) ([]models.AssetMeter, error) {
+	_, err := s.assetRepo.GetAssetByID(assetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("asset not found")
+		}
+		return nil, err
+	}
+	return s.repo.GetAssetMetersByAssetID(assetID)
+}
+
+func (s *assetMeterService) GetAssetMeterByID(assetID, meterID string) (*models.AssetMeter, error) {
+	meter, err := s.repo.GetAssetMeterByID(meterID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("meter not found")
+		}
+		return nil, err
+	}
+	if meter.AssetID != assetID {
+		return nil, errors.New("meter not found")
+	}
+	return meter, nil
+}
+
+// RecordMeterReading stores a reading, moves the meter's current value forward
+// and triggers every conditional schedule whose threshold the reading crossed.
+// It returns the number of maintenance records that were created. The meter
+// row stays locked until the reading, the meter and the triggered records are
+// saved together, so concurrent readings cannot move the meter backwards or
+// trigger a schedule twice.
+func (s *assetMeterService) RecordMeterReading(assetID string, reading *models.MeterReading) (int, error) {
+	if reading.ID == "" {
+		reading.ID = utils.GenerateUUID()
+	}
+	if reading.ReadAt.IsZero() {
+		reading.ReadAt = time.Now()
+	}
+
+	triggered := 0
+	err := s.txManager.Transaction(func(tx *gorm.DB) error {
+		repo := s.repo.WithTx(tx)
+		meter, err := repo.GetAssetMeterByIDForUpdate(reading.MeterID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("meter not found")
+			}
+			return err
+		}
+		if meter.AssetID != assetID {
+			return errors.New("meter not found")
+		}
+
+		if reading.Value < meter.CurrentValue {
+			return errors.New("meter reading is lower than the current value")
+		}
+
+		if err := repo.CreateMeterReading(reading); err != nil {
+			return err
+		}
+
+		meter.CurrentValue = reading.Value
+		meter.LastReadingAt = &reading.ReadAt
+		if err := repo.UpdateAssetMeter(meter); err != nil {
+			return err
+		}
+
+		triggered, err = s.triggerMeterSchedules(tx, meter, reading)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return triggered, nil
+}
+
+func (s *assetMeterService) triggerMeterSchedules(tx *gorm.DB, meter *models.AssetMeter, reading *models.MeterReading) (int, error) {
+	scheduleRepo := s.scheduleRepo.WithTx(tx)
+	schedules, err := scheduleRepo.GetMaintenanceSchedulesByMeterID(meter.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	triggered := 0
+	for i := range schedules {
+		schedule := &schedules[i]
+		if schedule.ScheduleType != consts.ScheduleTypeConditional || schedule.MeterThreshold == nil {
+			continue
+		}
+		if isSuspendedOn(schedule, reading.ReadAt) {
+			continue
+		}
+		if reading.Value < *schedule.MeterThreshold {
+			continue
+		}
+
+		created, err := s.createMeterRecord(tx, schedule, meter, reading)
+		if err != nil {
+			return triggered, fmt.Errorf("failed to create maintenance record for schedule %s: %w", schedule.ID, err)
+		}
+		if created {
+			triggered++
+		}
+
+		schedule.MeterThreshold = nextMeterThreshold(schedule, reading.Value)
+		if err := scheduleRepo.UpdateMaintenanceSchedule(schedule); err != nil {
+			return triggered, err
+		}
+	}
+
+	return triggered, nil
+}
+
+func (s *assetMeterService) createMeterRecord(tx *gorm.DB, schedule *models.MaintenanceSchedule, meter *models.AssetMeter, reading *models.MeterReading) (bool, error) {
+	hasOpen, err := s.recordRepo.WithTx(tx).HasOpenMaintenanceRecord(schedule.ID)
+	if err != nil {
+		return false, err
+	}
+	if hasOpen {
+		return false, nil
+	}
+
+	record := newScheduledRecord(*schedule)
+	record.Description = fmt.Sprintf("%s reached %g %s (threshold %g) on %s",
+		meter.Name, reading.Value, meter.Unit, *schedule.MeterThreshold, schedule.Asset.Name)
+	record.MaintenanceDate = reading.ReadAt
+	record.DueAt = nil
+	err = createScheduledRecord(s.assigner, schedule, record, func(record *models.MaintenanceRecord) error {
+		return s.recordService.CreateMaintenanceRecordTx(tx, record, "")
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if meter.Asset.Status != consts.AssetStatusUnderMaintenance {
+		reason := fmt.Sprintf("%s reached %g %s", meter.Name, reading.Value, meter.Unit)
+		if err := s.assetRepo.WithTx(tx).UpdateAssetStatus(meter.AssetID, consts.AssetStatusNeedMaintenance, reason); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+// nextMeterThreshold steps the threshold forward by the schedule's interval
+// until it lies beyond value, keeping the original cadence. A schedule without
+// an interval is a one-off threshold and is cleared once it fires.
+func nextMeterThreshold(schedule *models.MaintenanceSchedule, value float64) *float64 {
+	if schedule.MeterInterval == nil || *schedule.MeterInterval <= 0 {
+		return nil
+	}
+
+	next := *schedule.MeterThreshold
+	for next <= value {
+		next += *schedule.MeterInterval
+	}
+	return &next
+}
+
+func (s *assetMeterService) GetMeterReadings(assetID, meterID string, page, itemsPerPage int) ([]models.MeterReading, int64, error) {
+	if _, err := s.GetAssetMeterByID(assetID, meterID); err != nil {
+		return nil, 0, err
+	}
+	return s.repo.GetMeterReadings(meterID, page, itemsPerPage)
+}
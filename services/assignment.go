@@ -0,0 +1,290 @@
+package services
+
+import (
+	"errors"
+	"log"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+
+	"gorm.io/gorm"
+)
+
+// TechnicianLoad is a technician's number of open maintenance records before
+// and after a rebalance.
+type TechnicianLoad struct {
+	Technician models.User
+	Before     int
+	After      int
+}
+
+type RebalanceReport struct {
+	Reassigned  int
+	Technicians []TechnicianLoad
+}
+
+type AssignmentService interface {
+	PickTechnician(categoryID string) (*models.User, error)
+	ValidateTechnician(userID string) error
+	AssignRecord(record *models.MaintenanceRecord, categoryID string) error
+	GetTechnicianCategories(userID string) ([]models.TechnicianCategory, error)
+	SetTechnicianCategories(userID string, categoryIDs []string) ([]models.TechnicianCategory, error)
+	RebalanceOpenRecords(dryRun bool) (*RebalanceReport, error)
+}
+
+type assignmentService struct {
+	userRepo     repositories.UserRepository
+	recordRepo   repositories.MaintenanceRecordRepository
+	categoryRepo repositories.AssetCategoryRepository
+	affinityRepo repositories.TechnicianCategoryRepository
+	txManager    repositories.TxManager
+}
+
+func NewAssignmentService(
+	userRepo repositories.UserRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+	categoryRepo repositories.AssetCategoryRepository,
+	affinityRepo repositories.TechnicianCategoryRepository,
+	txManager repositories.TxManager,
+) AssignmentService {
+	return &assignmentService{
+		userRepo:     userRepo,
+		recordRepo:   recordRepo,
+		categoryRepo: categoryRepo,
+		affinityRepo: affinityRepo,
+		txManager:    txManager,
+	}
+}
+
+// PickTechnician returns the technician with the fewest open records,
+// preferring technicians linked to categoryID when there are any. It returns
+// nil when there are no technicians at all.
+func (s *assignmentService) PickTechnician(categoryID string) (*models.User, error) {
+	technicians, err := s.userRepo.GetUsersByRole(consts.RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	load, err := s.recordRepo.CountOpenMaintenanceRecordsByPerformer()
+	if err != nil {
+		return nil, err
+	}
+	affinity, err := s.categoryAffinity()
+	if err != nil {
+		return nil, err
+	}
+	return pickLeastLoaded(technicians, load, affinity[categoryID], ""), nil
+}
+
+func (s *assignmentService) ValidateTechnician(userID string) error {
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("assigned technician not found")
+		}
+		return err
+	}
+	if user.Role != consts.RoleTechnician {
+		return errors.New("assigned technician not found")
+	}
+	return nil
+}
+
+// AssignRecord sets the performer of an unassigned record to the technician
+// picked for categoryID. The record stays unassigned when there are no
+// technicians.
+func (s *assignmentService) AssignRecord(record *models.MaintenanceRecord, categoryID string) error {
+	if record.PerformedBy != nil && *record.PerformedBy != "" {
+		return nil
+	}
+
+	technician, err := s.PickTechnician(categoryID)
+	if err != nil {
+		return err
+	}
+	if technician != nil {
+		record.PerformedBy = &technician.ID
+	}
+	return nil
+}
+
+func (s *assignmentService) GetTechnicianCategories(userID string) ([]models.TechnicianCategory, error) {
+	if err := s.findTechnician(userID); err != nil {
+		return nil, err
+	}
+	return s.affinityRepo.GetTechnicianCategories(userID)
+}
+
+func (s *assignmentService) SetTechnicianCategories(userID string, categoryIDs []string) ([]models.TechnicianCategory, error) {
+	if err := s.findTechnician(userID); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	unique := make([]string, 0, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		if seen[categoryID] {
+			continue
+		}
+		seen[categoryID] = true
+
+		if _, err := s.categoryRepo.GetAssetCategoryByID(categoryID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("asset category not found")
+			}
+			return nil, err
+		}
+		unique = append(unique, categoryID)
+	}
+
+	if err := s.affinityRepo.ReplaceTechnicianCategories(userID, unique); err != nil {
+		return nil, err
+	}
+	return s.affinityRepo.GetTechnicianCategories(userID)
+}
+
+// RebalanceOpenRecords spreads pending records across technicians by load and
+// category affinity. Only pending records are moved: records already in
+// progress or on hold stay with their technician but count towards the load.
+// The reassignments are saved in one transaction, so a failure leaves every
+// record with its technician. With dryRun the report is computed without
+// reassigning anything.
+func (s *assignmentService) RebalanceOpenRecords(dryRun bool) (*RebalanceReport, error) {
+	technicians, err := s.userRepo.GetUsersByRole(consts.RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	before, err := s.recordRepo.CountOpenMaintenanceRecordsByPerformer()
+	if err != nil {
+		return nil, err
+	}
+	affinity, err := s.categoryAffinity()
+	if err != nil {
+		return nil, err
+	}
+	pending, err := s.recordRepo.GetMaintenanceRecordsByStatus(consts.RecordStatusPending)
+	if err != nil {
+		return nil, err
+	}
+
+	load := make(map[string]int, len(before))
+	for performer, count := range before {
+		load[performer] = count
+	}
+	for _, record := range pending {
+		if record.PerformedBy != nil {
+			load[*record.PerformedBy]--
+		}
+	}
+
+	report := &RebalanceReport{}
+	var reassigned []models.MaintenanceRecord
+	for _, record := range pending {
+		current := ""
+		if record.PerformedBy != nil {
+			current = *record.PerformedBy
+		}
+
+		technician := pickLeastLoaded(technicians, load, affinity[record.Asset.CategoryID], current)
+		if technician == nil {
+			break
+		}
+		load[technician.ID]++
+		if technician.ID == current {
+			continue
+		}
+
+		performer := technician.ID
+		record.PerformedBy = &performer
+		reassigned = append(reassigned, record)
+		report.Reassigned++
+	}
+
+	if !dryRun && len(reassigned) > 0 {
+		err := s.txManager.Transaction(func(tx *gorm.DB) error {
+			recordRepo := s.recordRepo.WithTx(tx)
+			for _, record := range reassigned {
+				if err := recordRepo.UpdateMaintenanceRecordPerformer(record.ID, record.PerformedBy); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, technician := range technicians {
+		report.Technicians = append(report.Technicians, TechnicianLoad{
+			Technician: technician,
+			Before:     before[technician.ID],
+			After:      load[technician.ID],
+		})
+	}
+
+	if !dryRun && report.Reassigned > 0 {
+		log.Printf("Rebalanced %d pending maintenance record(s)", report.Reassigned)
+	}
+	return report, nil
+}
+
+func (s *assignmentService) findTechnician(userID string) error {
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("technician not found")
+		}
+		return err
+	}
+	if user.Role != consts.RoleTechnician {
+		return errors.New("technician not found")
+	}
+	return nil
+}
+
+// categoryAffinity maps category IDs to the technicians linked to them.
+func (s *assignmentService) categoryAffinity() (map[string]map[string]bool, error) {
+	links, err := s.affinityRepo.GetAllTechnicianCategories()
+	if err != nil {
+		return nil, err
+	}
+
+	affinity := map[string]map[string]bool{}
+	for _, link := range links {
+		if affinity[link.CategoryID] == nil {
+			affinity[link.CategoryID] = map[string]bool{}
+		}
+		affinity[link.CategoryID][link.UserID] = true
+	}
+	return affinity, nil
+}
+
+// pickLeastLoaded returns the technician with the lowest load, limited to the
+// preferred technicians when any of them exist. Ties go to current so that a
+// rebalance does not move records needlessly, then to the first technician in
+// list order.
+func pickLeastLoaded(technicians []models.User, load map[string]int, preferred map[string]bool, current string) *models.User {
+	candidates := technicians
+	if len(preferred) > 0 {
+		var affine []models.User
+		for _, technician := range technicians {
+			if preferred[technician.ID] {
+				affine = append(affine, technician)
+			}
+		}
+		if len(affine) > 0 {
+			candidates = affine
+		}
+	}
+
+	var best *models.User
+	for i := range candidates {
+		technician := &candidates[i]
+		if best == nil || load[technician.ID] < load[best.ID] ||
+			(load[technician.ID] == load[best.ID] && technician.ID == current) {
+			best = technician
+		}
+	}
+	return best
+}
@@ -0,0 +1,266 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"unicode"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/storage"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+type AttachmentService interface {
+	UploadAttachment(ownerType, ownerID, kind, fileName string, content io.Reader, actorID, actorRole string) (*models.Attachment, error)
+	GetAttachments(ownerType, ownerID, actorID, actorRole string) ([]models.Attachment, error)
+	OpenAttachment(attachmentID string, thumbnail bool, actorID, actorRole string) (*models.Attachment, io.ReadCloser, error)
+	DeleteAttachment(attachmentID, actorID, actorRole string) error
+}
+
+type attachmentService struct {
+	repo            repositories.AttachmentRepository
+	assetRepo       repositories.AssetRepository
+	recordRepo      repositories.MaintenanceRecordRepository
+	workRequestRepo repositories.WorkRequestRepository
+	store           storage.BlobStore
+	maxBytes        int64
+}
+
+func NewAttachmentService(
+	repo repositories.AttachmentRepository,
+	assetRepo repositories.AssetRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+	workRequestRepo repositories.WorkRequestRepository,
+	store storage.BlobStore,
+	maxBytes int64,
+) AttachmentService {
+	return &attachmentService{
+		repo:            repo,
+		assetRepo:       assetRepo,
+		recordRepo:      recordRepo,
+		workRequestRepo: workRequestRepo,
+		store:           store,
+		maxBytes:        maxBytes,
+	}
+}
+
+// UploadAttachment stores a file for an asset, maintenance record or work
+// request. The content type is detected from the file itself rather than
+// trusted from the client, and images get a JPEG thumbnail.
+func (s *attachmentService) UploadAttachment(ownerType, ownerID, kind, fileName string, content io.Reader, actorID, actorRole string) (*models.Attachment, error) {
+	if err := s.checkOwnerAccess(ownerType, ownerID, actorID, actorRole); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(content, s.maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	if int64(len(data)) > s.maxBytes {
+		return nil, errors.New("file is too large")
+	}
+
+	contentType := detectContentType(data)
+	if !slices.Contains(consts.AttachmentContentTypes, contentType) {
+		return nil, errors.New("file type is not allowed")
+	}
+
+	if kind == "" {
+		kind = consts.AttachmentKindOther
+	}
+
+	id := utils.GenerateUUID()
+	attachment := &models.Attachment{
+		ID:          id,
+		OwnerType:   ownerType,
+		OwnerID:     ownerID,
+		Kind:        kind,
+		FileName:    sanitizeFileName(fileName),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		StorageKey:  path.Join("attachments", ownerType, ownerID, id),
+		UploadedBy:  actorID,
+	}
+
+	ctx := context.Background()
+	if err := s.store.Put(ctx, attachment.StorageKey, bytes.NewReader(data), attachment.Size, contentType); err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(consts.ThumbnailContentTypes, contentType) {
+		thumbnail, err := utils.MakeThumbnail(data, consts.AttachmentThumbnailSize)
+		if err != nil {
+			// The upload itself is fine; it just will not have a preview.
+			log.Printf("Failed to generate thumbnail for attachment %s: %v", id, err)
+		} else {
+			thumbnailKey := attachment.StorageKey + "-thumb.jpg"
+			if err := s.store.Put(ctx, thumbnailKey, bytes.NewReader(thumbnail), int64(len(thumbnail)), "image/jpeg"); err != nil {
+				s.removeBlobs(attachment)
+				return nil, err
+			}
+			attachment.ThumbnailKey = &thumbnailKey
+		}
+	}
+
+	if err := s.repo.CreateAttachment(attachment); err != nil {
+		s.removeBlobs(attachment)
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (s *attachmentService) GetAttachments(ownerType, ownerID, actorID, actorRole string) ([]models.Attachment, error) {
+	if err := s.checkOwnerAccess(ownerType, ownerID, actorID, actorRole); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAttachmentsByOwner(ownerType, ownerID)
+}
+
+// OpenAttachment returns the attachment and a reader for its file, or for its
+// thumbnail. The caller must close the reader.
+func (s *attachmentService) OpenAttachment(attachmentID string, thumbnail bool, actorID, actorRole string) (*models.Attachment, io.ReadCloser, error) {
+	attachment, err := s.findAttachment(attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkOwnerAccess(attachment.OwnerType, attachment.OwnerID, actorID, actorRole); err != nil {
+		return nil, nil, err
+	}
+
+	key := attachment.StorageKey
+	if thumbnail {
+		if attachment.ThumbnailKey == nil {
+			return nil, nil, errors.New("thumbnail not found")
+		}
+		key = *attachment.ThumbnailKey
+	}
+
+	reader, err := s.store.Get(context.Background(), key)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			return nil, nil, errors.New("attachment file not found")
+		}
+		return nil, nil, err
+	}
+	return attachment, reader, nil
+}
+
+// DeleteAttachment removes an attachment. Technicians may only remove files
+// they uploaded themselves.
+func (s *attachmentService) DeleteAttachment(attachmentID, actorID, actorRole string) error {
+	attachment, err := s.findAttachment(attachmentID)
+	if err != nil {
+		return err
+	}
+	if actorRole != consts.RoleSuperUser && actorRole != consts.RoleAdmin && attachment.UploadedBy != actorID {
+		return errors.New("access to attachment denied")
+	}
+
+	if err := s.repo.DeleteAttachment(attachmentID); err != nil {
+		return err
+	}
+	s.removeBlobs(attachment)
+	return nil
+}
+
+func (s *attachmentService) findAttachment(attachmentID string) (*models.Attachment, error) {
+	attachment, err := s.repo.GetAttachmentByID(attachmentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("attachment not found")
+		}
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// checkOwnerAccess makes sure the asset, record or work request exists and
+// that the actor may see its files. Asset files are visible to every role;
+// technicians only see files of records assigned to them or of unassigned
+// records, and of work requests they may see.
+func (s *attachmentService) checkOwnerAccess(ownerType, ownerID, actorID, actorRole string) error {
+	switch ownerType {
+	case consts.AttachmentOwnerAsset:
+		if _, err := s.assetRepo.GetAssetByID(ownerID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("asset not found")
+			}
+			return err
+		}
+		return nil
+	case consts.AttachmentOwnerRecord:
+		record, err := s.recordRepo.GetMaintenanceRecordByID(ownerID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("maintenance record not found")
+			}
+			return err
+		}
+		return checkRecordOwnership(record, actorID, actorRole)
+	case consts.AttachmentOwnerWorkRequest:
+		request, err := s.workRequestRepo.GetWorkRequestByID(ownerID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("work request not found")
+			}
+			return err
+		}
+		return checkWorkRequestAccess(request, actorID, actorRole)
+	default:
+		return errors.New("attachment not found")
+	}
+}
+
+// removeBlobs deletes an attachment's files. Failures only leave orphaned
+// blobs behind, so they are logged rather than returned.
+func (s *attachmentService) removeBlobs(attachment *models.Attachment) {
+	keys := []string{attachment.StorageKey}
+	if attachment.ThumbnailKey != nil {
+		keys = append(keys, *attachment.ThumbnailKey)
+	}
+	for _, key := range keys {
+		if err := s.store.Delete(context.Background(), key); err != nil {
+			log.Printf("Failed to delete blob %s: %v", key, err)
+		}
+	}
+}
+
+// detectContentType sniffs the media type of data, without parameters.
+func detectContentType(data []byte) string {
+	contentType := http.DetectContentType(data)
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = contentType[:i]
+	}
+	return strings.TrimSpace(contentType)
+}
+
+// sanitizeFileName keeps the base name of an uploaded file without control
+// characters, so it is safe to echo back in headers.
+func sanitizeFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == "/" {
+		name = "file"
+	}
+	return truncate(name, 255)
+}
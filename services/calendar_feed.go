@@ -0,0 +1,201 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// calendarFeedLookback keeps recently missed occurrences in the feed.
+	calendarFeedLookback = 30 * 24 * time.Hour
+	// calendarFeedHorizon is how far ahead schedules are expanded.
+	calendarFeedHorizon = 180 * 24 * time.Hour
+)
+
+type CalendarFeedService interface {
+	CreateFeedToken(userID, name string) (*models.CalendarFeedToken, string, error)
+	GetFeedTokens(userID string) ([]models.CalendarFeedToken, error)
+	RevokeFeedToken(userID, tokenID string) error
+	BuildFeed(token string, now time.Time) (string, error)
+}
+
+type calendarFeedService struct {
+	repo         repositories.CalendarFeedTokenRepository
+	scheduleRepo repositories.MaintenanceScheduleRepository
+	recordRepo   repositories.MaintenanceRecordRepository
+	holidayRepo  repositories.HolidayCalendarRepository
+}
+
+func NewCalendarFeedService(
+	repo repositories.CalendarFeedTokenRepository,
+	scheduleRepo repositories.MaintenanceScheduleRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+	holidayRepo repositories.HolidayCalendarRepository,
+) CalendarFeedService {
+	return &calendarFeedService{repo: repo, scheduleRepo: scheduleRepo, recordRepo: recordRepo, holidayRepo: holidayRepo}
+}
+
+// CreateFeedToken issues a new feed token for the user. The plain token is
+// returned once and cannot be recovered afterwards.
+func (s *calendarFeedService) CreateFeedToken(userID, name string) (*models.CalendarFeedToken, string, error) {
+	plain, err := utils.GenerateSecureToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	token := &models.CalendarFeedToken{
+		ID:        utils.GenerateUUID(),
+		UserID:    userID,
+		Name:      name,
+		TokenHash: utils.HashToken(plain),
+	}
+	if err := s.repo.CreateCalendarFeedToken(token); err != nil {
+		return nil, "", err
+	}
+	return token, plain, nil
+}
+
+func (s *calendarFeedService) GetFeedTokens(userID string) ([]models.CalendarFeedToken, error) {
+	return s.repo.GetCalendarFeedTokensByUserID(userID)
+}
+
+func (s *calendarFeedService) RevokeFeedToken(userID, tokenID string) error {
+	token, err := s.repo.GetCalendarFeedTokenByID(tokenID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("calendar feed token not found")
+		}
+		return err
+	}
+	if token.UserID != userID {
+		return errors.New("calendar feed token not found")
+	}
+	return s.repo.RevokeCalendarFeedToken(tokenID, time.Now())
+}
+
+// BuildFeed renders the iCalendar feed for the owner of token: upcoming
+// occurrences of the schedules assigned to them and their open records.
+func (s *calendarFeedService) BuildFeed(plain string, now time.Time) (string, error) {
+	token, err := s.repo.GetCalendarFeedTokenByHash(utils.HashToken(plain))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", errors.New("calendar feed not found")
+		}
+		return "", err
+	}
+	if token.RevokedAt != nil {
+		return "", errors.New("calendar feed not found")
+	}
+
+	records, err := s.recordRepo.GetOpenMaintenanceRecordsByPerformer(token.UserID)
+	if err != nil {
+		return "", err
+	}
+	schedules, err := s.scheduleRepo.GetMaintenanceSchedulesForCalendar("", token.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	var events []utils.ICalEvent
+	recorded := map[string]bool{}
+
+	for _, record := range records {
+		if record.ScheduleID != nil && record.DueAt != nil {
+			recorded[occurrenceKey(*record.ScheduleID, *record.DueAt)] = true
+		}
+		events = append(events, recordFeedEvent(record))
+	}
+
+	resolver := newClosedDayResolver(s.holidayRepo)
+	from, to := now.Add(-calendarFeedLookback), now.Add(calendarFeedHorizon)
+	for i := range schedules {
+		schedule := &schedules[i]
+		occurrences, err := occurrencesBetween(schedule, from, to)
+		if err != nil {
+			log.Printf("Skipping schedule %s in calendar feed: %v", schedule.ID, err)
+			continue
+		}
+		for _, occurrence := range occurrences {
+			if recorded[occurrenceKey(schedule.ID, occurrence)] {
+				continue
+			}
+			date, err := resolver.effectiveDate(schedule, occurrence)
+			if err != nil {
+				return "", err
+			}
+			if isSuspendedOn(schedule, date) {
+				continue
+			}
+			events = append(events, scheduleFeedEvent(schedule, occurrence, date))
+		}
+	}
+
+	if err := s.repo.TouchCalendarFeedToken(token.ID, now); err != nil {
+		log.Printf("Failed to update last use of calendar feed token %s: %v", token.ID, err)
+	}
+
+	name := "Jaga maintenance"
+	if token.User.Name != "" {
+		name = "Jaga maintenance - " + token.User.Name
+	}
+	return utils.BuildICalendar(name, events, now), nil
+}
+
+// scheduleFeedEvent builds the event for one occurrence of a schedule, placed
+// on its effective date. The UID is derived from the schedule and the nominal
+// occurrence date so a refreshed feed replaces the same event instead of
+// duplicating it.
+func scheduleFeedEvent(schedule *models.MaintenanceSchedule, occurrence, date time.Time) utils.ICalEvent {
+	return utils.ICalEvent{
+		UID:          fmt.Sprintf("schedule-%s-%s@jaga", schedule.ID, occurrence.UTC().Format("20060102")),
+		Summary:      fmt.Sprintf("%s maintenance: %s", capitalize(schedule.ScheduleType), schedule.Asset.Name),
+		Description:  feedDescription(schedule.Asset, fmt.Sprintf("Planned %s maintenance", schedule.ScheduleType)),
+		Location:     schedule.Asset.Location,
+		Start:        date.UTC(),
+		AllDay:       true,
+		Status:       "TENTATIVE",
+		Sequence:     schedule.UpdatedAt.Unix(),
+		LastModified: schedule.UpdatedAt,
+	}
+}
+
+func recordFeedEvent(record models.MaintenanceRecord) utils.ICalEvent {
+	return utils.ICalEvent{
+		UID:          fmt.Sprintf("record-%s@jaga", record.ID),
+		Summary:      fmt.Sprintf("Maintenance (%s): %s", strings.ReplaceAll(record.Status, "_", " "), record.Asset.Name),
+		Description:  feedDescription(record.Asset, record.Description),
+		Location:     record.Asset.Location,
+		Start:        record.MaintenanceDate.UTC(),
+		AllDay:       true,
+		Status:       "CONFIRMED",
+		Sequence:     record.UpdatedAt.Unix(),
+		LastModified: record.UpdatedAt,
+	}
+}
+
+func feedDescription(asset models.Asset, description string) string {
+	lines := []string{"Asset: " + asset.Name}
+	if asset.Location != "" {
+		lines = append(lines, "Location: "+asset.Location)
+	}
+	if description != "" {
+		lines = append(lines, "", description)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func capitalize(value string) string {
+	if value == "" {
+		return value
+	}
+	return strings.ToUpper(value[:1]) + value[1:]
+}
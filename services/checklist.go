@@ -0,0 +1,224 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+type ChecklistService interface {
+	GetScheduleChecklist(scheduleID string) ([]models.ChecklistTemplateItem, error)
+	SetScheduleChecklist(scheduleID string, items []models.ChecklistTemplateItem) ([]models.ChecklistTemplateItem, error)
+	GetCategoryChecklist(categoryID string) ([]models.ChecklistTemplateItem, error)
+	SetCategoryChecklist(categoryID string, items []models.ChecklistTemplateItem) ([]models.ChecklistTemplateItem, error)
+	GetRecordChecklist(recordID string) ([]models.RecordChecklistItem, error)
+	UpdateRecordChecklistItem(recordID, itemID, actorID, actorRole string, completed bool, note string, measuredValue *float64) (*models.RecordChecklistItem, error)
+}
+
+type checklistService struct {
+	repo         repositories.ChecklistRepository
+	scheduleRepo repositories.MaintenanceScheduleRepository
+	categoryRepo repositories.AssetCategoryRepository
+	recordRepo   repositories.MaintenanceRecordRepository
+}
+
+func NewChecklistService(
+	repo repositories.ChecklistRepository,
+	scheduleRepo repositories.MaintenanceScheduleRepository,
+	categoryRepo repositories.AssetCategoryRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+) ChecklistService {
+	return &checklistService{
+		repo:         repo,
+		scheduleRepo: scheduleRepo,
+		categoryRepo: categoryRepo,
+		recordRepo:   recordRepo,
+	}
+}
+
+func (s *checklistService) GetScheduleChecklist(scheduleID string) ([]models.ChecklistTemplateItem, error) {
+	if err := s.findSchedule(scheduleID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetScheduleChecklist(scheduleID)
+}
+
+// SetScheduleChecklist replaces the schedule's checklist with items, in the
+// given order.
+func (s *checklistService) SetScheduleChecklist(scheduleID string, items []models.ChecklistTemplateItem) ([]models.ChecklistTemplateItem, error) {
+	if err := s.findSchedule(scheduleID); err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		items[i].ScheduleID = &scheduleID
+		items[i].CategoryID = nil
+	}
+	if err := s.repo.ReplaceScheduleChecklist(scheduleID, prepareTemplateItems(items)); err != nil {
+		return nil, err
+	}
+	return s.repo.GetScheduleChecklist(scheduleID)
+}
+
+func (s *checklistService) GetCategoryChecklist(categoryID string) ([]models.ChecklistTemplateItem, error) {
+	if err := s.findCategory(categoryID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetCategoryChecklist(categoryID)
+}
+
+// SetCategoryChecklist replaces the category's checklist with items, in the
+// given order.
+func (s *checklistService) SetCategoryChecklist(categoryID string, items []models.ChecklistTemplateItem) ([]models.ChecklistTemplateItem, error) {
+	if err := s.findCategory(categoryID); err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		items[i].CategoryID = &categoryID
+		items[i].ScheduleID = nil
+	}
+	if err := s.repo.ReplaceCategoryChecklist(categoryID, prepareTemplateItems(items)); err != nil {
+		return nil, err
+	}
+	return s.repo.GetCategoryChecklist(categoryID)
+}
+
+func (s *checklistService) GetRecordChecklist(recordID string) ([]models.RecordChecklistItem, error) {
+	if _, err := s.findRecord(recordID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRecordChecklist(recordID)
+}
+
+// UpdateRecordChecklistItem ticks a checklist step off, or unticks it, with an
+// optional note and measured value. Steps of closed records cannot change.
+func (s *checklistService) UpdateRecordChecklistItem(recordID, itemID, actorID, actorRole string, completed bool, note string, measuredValue *float64) (*models.RecordChecklistItem, error) {
+	record, err := s.findRecord(recordID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRecordOwnership(record, actorID, actorRole); err != nil {
+		return nil, err
+	}
+	if !isOpenRecordStatus(record.Status) {
+		return nil, errors.New("maintenance record is closed")
+	}
+
+	item, err := s.repo.GetRecordChecklistItemByID(itemID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("checklist item not found")
+		}
+		return nil, err
+	}
+	if item.RecordID != recordID {
+		return nil, errors.New("checklist item not found")
+	}
+
+	item.Note = note
+	item.MeasuredValue = measuredValue
+	if completed {
+		if item.CompletedAt == nil {
+			now := time.Now()
+			item.CompletedAt = &now
+			item.CompletedBy = &actorID
+		}
+	} else {
+		item.CompletedAt = nil
+		item.CompletedBy = nil
+	}
+
+	if err := s.repo.UpdateRecordChecklistItem(item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (s *checklistService) findSchedule(scheduleID string) error {
+	if _, err := s.scheduleRepo.GetMaintenanceScheduleByID(scheduleID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("maintenance schedule not found")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *checklistService) findCategory(categoryID string) error {
+	if _, err := s.categoryRepo.GetAssetCategoryByID(categoryID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("asset category not found")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *checklistService) findRecord(recordID string) (*models.MaintenanceRecord, error) {
+	record, err := s.recordRepo.GetMaintenanceRecordByID(recordID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("maintenance record not found")
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+// prepareTemplateItems assigns IDs and positions following the slice order.
+func prepareTemplateItems(items []models.ChecklistTemplateItem) []models.ChecklistTemplateItem {
+	for i := range items {
+		items[i].ID = utils.GenerateUUID()
+		items[i].Position = i + 1
+	}
+	return items
+}
+
+// copyChecklist gives a new record its own copy of the checklist of its
+// schedule or, when the schedule has none, of its asset's category.
+func copyChecklist(repo repositories.ChecklistRepository, record *models.MaintenanceRecord, categoryID string) error {
+	var template []models.ChecklistTemplateItem
+	var err error
+	if record.ScheduleID != nil && *record.ScheduleID != "" {
+		template, err = repo.GetScheduleChecklist(*record.ScheduleID)
+		if err != nil {
+			return err
+		}
+	}
+	if len(template) == 0 && categoryID != "" {
+		template, err = repo.GetCategoryChecklist(categoryID)
+		if err != nil {
+			return err
+		}
+	}
+
+	items := make([]models.RecordChecklistItem, len(template))
+	for i, step := range template {
+		items[i] = models.RecordChecklistItem{
+			ID:          utils.GenerateUUID(),
+			RecordID:    record.ID,
+			Position:    step.Position,
+			Title:       step.Title,
+			Description: step.Description,
+			Required:    step.Required,
+			Unit:        step.Unit,
+		}
+	}
+	return repo.CreateRecordChecklistItems(items)
+}
+
+func isOpenRecordStatus(status string) bool {
+	for _, open := range consts.OpenMaintenanceRecordStatuses {
+		if status == open {
+			return true
+		}
+	}
+	return false
+}
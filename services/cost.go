@@ -0,0 +1,284 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+// maxCostRollupWindow bounds the date range of a category cost rollup.
+const maxCostRollupWindow = 5 * 366 * 24 * time.Hour
+
+// CostLine is one labor entry, part or vendor invoice charged to a record.
+type CostLine struct {
+	Type        string
+	ReferenceID string
+	Description string
+	Quantity    float64
+	UnitCost    float64
+	Amount      float64
+}
+
+type RecordCosts struct {
+	Lines      []CostLine
+	LaborCost  float64
+	PartsCost  float64
+	VendorCost float64
+}
+
+type AssetCostSummary struct {
+	Asset       models.Asset
+	Maintenance repositories.MaintenanceCosts
+	// PurchaseCost only counts when the asset was bought within the range.
+	PurchaseCost float64
+}
+
+type CategoryCostRollup struct {
+	Category    models.AssetCategory
+	Purchases   repositories.PurchaseCosts
+	Maintenance repositories.MaintenanceCosts
+}
+
+type CostService interface {
+	GetRecordCosts(recordID string) (*RecordCosts, error)
+	GetVendorInvoices(recordID string) ([]models.VendorInvoice, error)
+	CreateVendorInvoice(invoice *models.VendorInvoice) error
+	DeleteVendorInvoice(recordID, invoiceID string) error
+	GetAssetCostSummary(assetID string, from, to *time.Time) (*AssetCostSummary, error)
+	GetCategoryCostRollup(from, to time.Time) ([]CategoryCostRollup, error)
+}
+
+type costService struct {
+	repo         repositories.CostRepository
+	invoiceRepo  repositories.VendorInvoiceRepository
+	recordRepo   repositories.MaintenanceRecordRepository
+	assetRepo    repositories.AssetRepository
+	categoryRepo repositories.AssetCategoryRepository
+	laborRepo    repositories.LaborEntryRepository
+	partRepo     repositories.PartRepository
+}
+
+func NewCostService(
+	repo repositories.CostRepository,
+	invoiceRepo repositories.VendorInvoiceRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+	assetRepo repositories.AssetRepository,
+	categoryRepo repositories.AssetCategoryRepository,
+	laborRepo repositories.LaborEntryRepository,
+	partRepo repositories.PartRepository,
+) CostService {
+	return &costService{
+		repo:         repo,
+		invoiceRepo:  invoiceRepo,
+		recordRepo:   recordRepo,
+		assetRepo:    assetRepo,
+		categoryRepo: categoryRepo,
+		laborRepo:    laborRepo,
+		partRepo:     partRepo,
+	}
+}
+
+// GetRecordCosts lists what a record cost: stopped labor at the hourly rate
+// of the time, parts at their unit cost unless the record was cancelled, and
+// vendor invoices.
+func (s *costService) GetRecordCosts(recordID string) (*RecordCosts, error) {
+	record, err := s.findRecord(recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	costs := &RecordCosts{Lines: []CostLine{}}
+
+	entries, err := s.laborRepo.GetLaborEntriesByRecord(recordID)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.EndedAt == nil {
+			continue
+		}
+		line := CostLine{
+			Type:        consts.CostLineLabor,
+			ReferenceID: entry.ID,
+			Description: entry.User.Name,
+			Quantity:    float64(entry.DurationSeconds) / 3600,
+		}
+		if entry.HourlyRate != nil {
+			line.UnitCost = *entry.HourlyRate
+		}
+		line.Amount = line.Quantity * line.UnitCost
+		costs.LaborCost += line.Amount
+		costs.Lines = append(costs.Lines, line)
+	}
+
+	if record.Status != consts.RecordStatusCancelled {
+		recordParts, err := s.partRepo.GetRecordParts(recordID)
+		if err != nil {
+			return nil, err
+		}
+		for _, recordPart := range recordParts {
+			line := CostLine{
+				Type:        consts.CostLinePart,
+				ReferenceID: recordPart.ID,
+				Description: recordPart.Part.Name,
+				Quantity:    recordPart.Quantity,
+			}
+			if recordPart.UnitCost != nil {
+				line.UnitCost = *recordPart.UnitCost
+			}
+			line.Amount = line.Quantity * line.UnitCost
+			costs.PartsCost += line.Amount
+			costs.Lines = append(costs.Lines, line)
+		}
+	}
+
+	invoices, err := s.invoiceRepo.GetVendorInvoicesByRecord(recordID)
+	if err != nil {
+		return nil, err
+	}
+	for _, invoice := range invoices {
+		costs.VendorCost += invoice.Amount
+		costs.Lines = append(costs.Lines, CostLine{
+			Type:        consts.CostLineVendor,
+			ReferenceID: invoice.ID,
+			Description: invoice.Vendor,
+			Quantity:    1,
+			UnitCost:    invoice.Amount,
+			Amount:      invoice.Amount,
+		})
+	}
+
+	return costs, nil
+}
+
+func (s *costService) GetVendorInvoices(recordID string) ([]models.VendorInvoice, error) {
+	if _, err := s.findRecord(recordID); err != nil {
+		return nil, err
+	}
+	return s.invoiceRepo.GetVendorInvoicesByRecord(recordID)
+}
+
+func (s *costService) CreateVendorInvoice(invoice *models.VendorInvoice) error {
+	if _, err := s.findRecord(invoice.RecordID); err != nil {
+		return err
+	}
+
+	if invoice.ID == "" {
+		invoice.ID = utils.GenerateUUID()
+	}
+	return s.invoiceRepo.CreateVendorInvoice(invoice)
+}
+
+func (s *costService) DeleteVendorInvoice(recordID, invoiceID string) error {
+	invoice, err := s.invoiceRepo.GetVendorInvoiceByID(invoiceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("vendor invoice not found")
+		}
+		return err
+	}
+	if invoice.RecordID != recordID {
+		return errors.New("vendor invoice not found")
+	}
+	return s.invoiceRepo.DeleteVendorInvoice(invoiceID)
+}
+
+// GetAssetCostSummary totals what an asset has cost, optionally limited to
+// records with a maintenance date in [from, to). Without a range the purchase
+// cost always counts; with one, only when the asset was bought within it.
+func (s *costService) GetAssetCostSummary(assetID string, from, to *time.Time) (*AssetCostSummary, error) {
+	if from != nil && to != nil && !to.After(*from) {
+		return nil, errors.New("report start must be before its end")
+	}
+
+	asset, err := s.assetRepo.GetAssetByID(assetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("asset not found")
+		}
+		return nil, err
+	}
+
+	maintenance, err := s.repo.GetAssetMaintenanceCosts(assetID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &AssetCostSummary{Asset: *asset, Maintenance: *maintenance}
+	if asset.PurchaseCost != nil && purchasedWithin(asset.PurchaseDate, from, to) {
+		summary.PurchaseCost = *asset.PurchaseCost
+	}
+	return summary, nil
+}
+
+// GetCategoryCostRollup totals per asset category the purchase cost of the
+// assets bought in [from, to) and the cost of the records with a maintenance
+// date in that range.
+func (s *costService) GetCategoryCostRollup(from, to time.Time) ([]CategoryCostRollup, error) {
+	if !to.After(from) {
+		return nil, errors.New("report start must be before its end")
+	}
+	if to.Sub(from) > maxCostRollupWindow {
+		return nil, errors.New("report range is too long")
+	}
+
+	categories, err := s.categoryRepo.GetAssetCategories()
+	if err != nil {
+		return nil, err
+	}
+	maintenance, err := s.repo.GetCategoryMaintenanceCosts(from, to)
+	if err != nil {
+		return nil, err
+	}
+	purchases, err := s.repo.GetCategoryPurchaseCosts(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	rollup := make([]CategoryCostRollup, len(categories))
+	for i, category := range categories {
+		rollup[i].Category = category
+		if costs, ok := maintenance[category.ID]; ok {
+			rollup[i].Maintenance = *costs
+		}
+		if costs, ok := purchases[category.ID]; ok {
+			rollup[i].Purchases = *costs
+		}
+	}
+	return rollup, nil
+}
+
+func (s *costService) findRecord(recordID string) (*models.MaintenanceRecord, error) {
+	record, err := s.recordRepo.GetMaintenanceRecordByID(recordID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("maintenance record not found")
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+// purchasedWithin reports whether a purchase date falls in [from, to). Open
+// ends are unbounded; an unknown date only matches an unbounded range.
+func purchasedWithin(purchaseDate, from, to *time.Time) bool {
+	if from == nil && to == nil {
+		return true
+	}
+	if purchaseDate == nil {
+		return false
+	}
+	if from != nil && purchaseDate.Before(*from) {
+		return false
+	}
+	if to != nil && !purchaseDate.Before(*to) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"errors"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+type FailureCodeService interface {
+	CreateFailureCode(code *models.FailureCode) error
+	GetFailureCodes(codeType string, includeInactive bool) ([]models.FailureCode, error)
+	GetFailureCodeByID(codeID string) (*models.FailureCode, error)
+	UpdateFailureCode(code *models.FailureCode, active *bool) error
+	DeleteFailureCode(codeID string) error
+	SetRecordFailureCodes(recordID string, problemCodeID, causeCodeID, remedyCodeID *string, actorID, actorRole string) (*models.MaintenanceRecord, error)
+}
+
+type failureCodeService struct {
+	repo       repositories.FailureCodeRepository
+	recordRepo repositories.MaintenanceRecordRepository
+}
+
+func NewFailureCodeService(repo repositories.FailureCodeRepository, recordRepo repositories.MaintenanceRecordRepository) FailureCodeService {
+	return &failureCodeService{repo: repo, recordRepo: recordRepo}
+}
+
+func (s *failureCodeService) CreateFailureCode(code *models.FailureCode) error {
+	if code.ID == "" {
+		code.ID = utils.GenerateUUID()
+	}
+	code.Active = true
+	if err := s.repo.CreateFailureCode(code); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errors.New("failure code already exists")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *failureCodeService) GetFailureCodes(codeType string, includeInactive bool) ([]models.FailureCode, error) {
+	return s.repo.GetFailureCodes(codeType, includeInactive)
+}
+
+func (s *failureCodeService) GetFailureCodeByID(codeID string) (*models.FailureCode, error) {
+	code, err := s.repo.GetFailureCodeByID(codeID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("failure code not found")
+		}
+		return nil, err
+	}
+	return code, nil
+}
+
+// UpdateFailureCode changes a code's details. Its type cannot change, since
+// records already carry it in that role. A nil active keeps the code's state.
+func (s *failureCodeService) UpdateFailureCode(code *models.FailureCode, active *bool) error {
+	existing, err := s.GetFailureCodeByID(code.ID)
+	if err != nil {
+		return err
+	}
+
+	if code.Code != "" {
+		existing.Code = code.Code
+	}
+	if code.Name != "" {
+		existing.Name = code.Name
+	}
+	existing.Description = code.Description
+	if active != nil {
+		existing.Active = *active
+	}
+
+	if err := s.repo.UpdateFailureCode(existing); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errors.New("failure code already exists")
+		}
+		return err
+	}
+	*code = *existing
+	return nil
+}
+
+// DeleteFailureCode deletes a code no record carries. Codes in use can only
+// be deactivated.
+func (s *failureCodeService) DeleteFailureCode(codeID string) error {
+	if _, err := s.GetFailureCodeByID(codeID); err != nil {
+		return err
+	}
+
+	inUse, err := s.repo.IsFailureCodeInUse(codeID)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return errors.New("failure code is in use")
+	}
+	return s.repo.DeleteFailureCode(codeID)
+}
+
+// SetRecordFailureCodes classifies a record with problem, cause and remedy
+// codes. A nil code ID keeps the current code and an empty one clears it.
+// Technicians may only classify records assigned to them.
+func (s *failureCodeService) SetRecordFailureCodes(recordID string, problemCodeID, causeCodeID, remedyCodeID *string, actorID, actorRole string) (*models.MaintenanceRecord, error) {
+	record, err := s.recordRepo.GetMaintenanceRecordByID(recordID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("maintenance record not found")
+		}
+		return nil, err
+	}
+	if err := checkRecordOwnership(record, actorID, actorRole); err != nil {
+		return nil, err
+	}
+
+	problem, err := s.resolveRecordCode(record.ProblemCodeID, problemCodeID, consts.FailureCodeTypeProblem)
+	if err != nil {
+		return nil, err
+	}
+	cause, err := s.resolveRecordCode(record.CauseCodeID, causeCodeID, consts.FailureCodeTypeCause)
+	if err != nil {
+		return nil, err
+	}
+	remedy, err := s.resolveRecordCode(record.RemedyCodeID, remedyCodeID, consts.FailureCodeTypeRemedy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.recordRepo.UpdateMaintenanceRecordFailureCodes(recordID, problem, cause, remedy); err != nil {
+		return nil, err
+	}
+	record.ProblemCodeID = problem
+	record.CauseCodeID = cause
+	record.RemedyCodeID = remedy
+	return record, nil
+}
+
+// resolveRecordCode works out the code a record carries after an update. A
+// newly set code must exist, be of the expected type and be active; a code the
+// record already carries stays valid after it is deactivated.
+func (s *failureCodeService) resolveRecordCode(current, update *string, codeType string) (*string, error) {
+	if update == nil {
+		return current, nil
+	}
+	if *update == "" {
+		return nil, nil
+	}
+	if current != nil && *current == *update {
+		return current, nil
+	}
+
+	code, err := s.GetFailureCodeByID(*update)
+	if err != nil {
+		return nil, err
+	}
+	if code.Type != codeType {
+		return nil, errors.New("failure code type does not match")
+	}
+	if !code.Active {
+		return nil, errors.New("failure code is inactive")
+	}
+	return &code.ID, nil
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// maxImportedHolidayDays bounds multi-day events in an imported file.
+	maxImportedHolidayDays = 31
+	// holidayRecurrenceYears is how far recurring holidays are expanded on
+	// import.
+	holidayRecurrenceYears = 10
+)
+
+type HolidayCalendarService interface {
+	CreateHolidayCalendar(calendar *models.HolidayCalendar) error
+	GetHolidayCalendars() ([]models.HolidayCalendar, error)
+	GetHolidayCalendarByID(calendarID string) (*models.HolidayCalendar, error)
+	UpdateHolidayCalendar(calendar *models.HolidayCalendar) error
+	DeleteHolidayCalendar(calendarID string) error
+	GetHolidays(calendarID string, from, to *time.Time) ([]models.Holiday, error)
+	AddHoliday(holiday *models.Holiday) error
+	DeleteHoliday(calendarID, holidayID string) error
+	ImportHolidays(calendarID string, r io.Reader) (int, error)
+}
+
+type holidayCalendarService struct {
+	repo repositories.HolidayCalendarRepository
+}
+
+func NewHolidayCalendarService(repo repositories.HolidayCalendarRepository) HolidayCalendarService {
+	return &holidayCalendarService{repo: repo}
+}
+
+func (s *holidayCalendarService) CreateHolidayCalendar(calendar *models.HolidayCalendar) error {
+	workingDays, err := normalizeWorkingDays(calendar.WorkingDays)
+	if err != nil {
+		return err
+	}
+	calendar.WorkingDays = workingDays
+
+	if calendar.ID == "" {
+		calendar.ID = utils.GenerateUUID()
+	}
+	if err := s.repo.CreateHolidayCalendar(calendar); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errors.New("holiday calendar name already exists")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *holidayCalendarService) GetHolidayCalendars() ([]models.HolidayCalendar, error) {
+	return s.repo.GetHolidayCalendars()
+}
+
+func (s *holidayCalendarService) GetHolidayCalendarByID(calendarID string) (*models.HolidayCalendar, error) {
+	calendar, err := s.repo.GetHolidayCalendarByID(calendarID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("holiday calendar not found")
+		}
+		return nil, err
+	}
+	return calendar, nil
+}
+
+func (s *holidayCalendarService) UpdateHolidayCalendar(calendar *models.HolidayCalendar) error {
+	existing, err := s.GetHolidayCalendarByID(calendar.ID)
+	if err != nil {
+		return err
+	}
+
+	if calendar.Name != "" {
+		existing.Name = calendar.Name
+	}
+	if calendar.WorkingDays != "" {
+		workingDays, err := normalizeWorkingDays(calendar.WorkingDays)
+		if err != nil {
+			return err
+		}
+		existing.WorkingDays = workingDays
+	}
+
+	if err := s.repo.UpdateHolidayCalendar(existing); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errors.New("holiday calendar name already exists")
+		}
+		return err
+	}
+	*calendar = *existing
+	return nil
+}
+
+func (s *holidayCalendarService) DeleteHolidayCalendar(calendarID string) error {
+	if _, err := s.GetHolidayCalendarByID(calendarID); err != nil {
+		return err
+	}
+
+	inUse, err := s.repo.IsHolidayCalendarInUse(calendarID)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return errors.New("holiday calendar is in use")
+	}
+	return s.repo.DeleteHolidayCalendar(calendarID)
+}
+
+func (s *holidayCalendarService) GetHolidays(calendarID string, from, to *time.Time) ([]models.Holiday, error) {
+	if _, err := s.GetHolidayCalendarByID(calendarID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetHolidays(calendarID, from, to)
+}
+
+func (s *holidayCalendarService) AddHoliday(holiday *models.Holiday) error {
+	if _, err := s.GetHolidayCalendarByID(holiday.CalendarID); err != nil {
+		return err
+	}
+
+	if holiday.ID == "" {
+		holiday.ID = utils.GenerateUUID()
+	}
+	holiday.Date = holidayDate(holiday.Date)
+	if err := s.repo.UpsertHolidays([]models.Holiday{*holiday}); err != nil {
+		return err
+	}
+
+	// The calendar may already have had a holiday on that date, which was
+	// renamed instead of inserted.
+	stored, err := s.repo.GetHolidays(holiday.CalendarID, &holiday.Date, &holiday.Date)
+	if err != nil {
+		return err
+	}
+	if len(stored) > 0 {
+		*holiday = stored[0]
+	}
+	return nil
+}
+
+func (s *holidayCalendarService) DeleteHoliday(calendarID, holidayID string) error {
+	holiday, err := s.repo.GetHolidayByID(holidayID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("holiday not found")
+		}
+		return err
+	}
+	if holiday.CalendarID != calendarID {
+		return errors.New("holiday not found")
+	}
+	return s.repo.DeleteHoliday(holidayID)
+}
+
+// ImportHolidays adds every day covered by the events of an iCalendar file to
+// the calendar and returns the number of days imported. Multi-day events
+// cover each day up to their end, and recurring events are expanded for
+// holidayRecurrenceYears. Days already on the calendar are renamed.
+func (s *holidayCalendarService) ImportHolidays(calendarID string, r io.Reader) (int, error) {
+	if _, err := s.GetHolidayCalendarByID(calendarID); err != nil {
+		return 0, err
+	}
+
+	events, err := utils.ParseICalendar(r)
+	if err != nil {
+		return 0, err
+	}
+
+	byDate := map[string]models.Holiday{}
+	var order []string
+	for _, event := range events {
+		for _, day := range importedHolidayDays(event) {
+			key := day.Format("2006-01-02")
+			if _, seen := byDate[key]; !seen {
+				order = append(order, key)
+			}
+			byDate[key] = models.Holiday{
+				ID:         utils.GenerateUUID(),
+				CalendarID: calendarID,
+				Date:       day,
+				Name:       truncate(event.Summary, 255),
+			}
+		}
+	}
+
+	holidays := make([]models.Holiday, len(order))
+	for i, key := range order {
+		holidays[i] = byDate[key]
+	}
+	if err := s.repo.UpsertHolidays(holidays); err != nil {
+		return 0, err
+	}
+	return len(holidays), nil
+}
+
+// importedHolidayDays returns the days an imported event closes the site.
+func importedHolidayDays(event utils.ICalParsedEvent) []time.Time {
+	starts := []time.Time{event.Start}
+	if event.RRule != "" {
+		if rule, err := utils.ParseRRule(event.RRule); err == nil {
+			starts = rule.Between(event.Start, event.Start, event.Start.AddDate(holidayRecurrenceYears, 0, 0))
+		}
+	}
+
+	length := 1
+	if !event.End.IsZero() {
+		length = int(holidayDate(event.End).Sub(holidayDate(event.Start)).Hours() / 24)
+		if !event.AllDay && !event.End.Equal(holidayDate(event.End)) {
+			// A timed event ending during a day still covers that day.
+			length++
+		}
+		if length < 1 {
+			length = 1
+		}
+		if length > maxImportedHolidayDays {
+			length = maxImportedHolidayDays
+		}
+	}
+
+	var days []time.Time
+	for _, start := range starts {
+		for i := 0; i < length; i++ {
+			days = append(days, holidayDate(start).AddDate(0, 0, i))
+		}
+	}
+	return days
+}
+
+// holidayDate drops the time of day, keeping the calendar date of t.
+func holidayDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func truncate(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[:max])
+}
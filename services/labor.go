@@ -0,0 +1,200 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+// maxLaborReportWindow bounds the date range of a labor report.
+const maxLaborReportWindow = 366 * 24 * time.Hour
+
+type LaborService interface {
+	StartTimer(recordID, actorID, note string, now time.Time) (*models.LaborEntry, error)
+	StopTimer(recordID, actorID string, now time.Time) (*models.LaborEntry, error)
+	LogLaborEntry(entry *models.LaborEntry, actorID, actorRole string, now time.Time) error
+	GetLaborEntries(recordID string) ([]models.LaborEntry, error)
+	DeleteLaborEntry(recordID, entryID, actorID, actorRole string) error
+	GetLaborTotals(groupBy string, from, to time.Time) ([]repositories.LaborTotal, error)
+}
+
+type laborService struct {
+	repo       repositories.LaborEntryRepository
+	recordRepo repositories.MaintenanceRecordRepository
+	userRepo   repositories.UserRepository
+}
+
+func NewLaborService(
+	repo repositories.LaborEntryRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+	userRepo repositories.UserRepository,
+) LaborService {
+	return &laborService{
+		repo:       repo,
+		recordRepo: recordRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// StartTimer starts the actor's timer on a record in progress at their current
+// hourly rate. Several technicians may time the same record, but each runs one
+// timer at a time.
+func (s *laborService) StartTimer(recordID, actorID, note string, now time.Time) (*models.LaborEntry, error) {
+	record, err := s.findRecord(recordID)
+	if err != nil {
+		return nil, err
+	}
+	if record.Status != consts.RecordStatusInProgress {
+		return nil, errors.New("maintenance record is not in progress")
+	}
+
+	if _, err := s.repo.GetRunningLaborEntryByUser(actorID); err == nil {
+		return nil, errors.New("a timer is already running")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	user, err := s.findUser(actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &models.LaborEntry{
+		ID:         utils.GenerateUUID(),
+		RecordID:   recordID,
+		UserID:     actorID,
+		StartedAt:  now,
+		HourlyRate: user.HourlyRate,
+		Note:       note,
+	}
+	if err := s.repo.CreateLaborEntry(entry); err != nil {
+		return nil, err
+	}
+	return s.repo.GetLaborEntryByID(entry.ID)
+}
+
+func (s *laborService) StopTimer(recordID, actorID string, now time.Time) (*models.LaborEntry, error) {
+	if _, err := s.findRecord(recordID); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.repo.GetRunningLaborEntryByUser(actorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("no timer is running on this maintenance record")
+		}
+		return nil, err
+	}
+	if entry.RecordID != recordID {
+		return nil, errors.New("no timer is running on this maintenance record")
+	}
+
+	if err := s.repo.StopLaborEntry(entry, now); err != nil {
+		return nil, err
+	}
+	return s.repo.GetLaborEntryByID(entry.ID)
+}
+
+// LogLaborEntry records work done without a timer. Admins may log time for
+// other users; everyone else only for themselves.
+func (s *laborService) LogLaborEntry(entry *models.LaborEntry, actorID, actorRole string, now time.Time) error {
+	if entry.UserID == "" {
+		entry.UserID = actorID
+	}
+	if entry.UserID != actorID && actorRole != consts.RoleSuperUser && actorRole != consts.RoleAdmin {
+		return errors.New("access to labor entry denied")
+	}
+	if _, err := s.findRecord(entry.RecordID); err != nil {
+		return err
+	}
+	user, err := s.findUser(entry.UserID)
+	if err != nil {
+		return err
+	}
+
+	endedAt := entry.StartedAt.Add(time.Duration(entry.DurationSeconds) * time.Second)
+	if endedAt.After(now) {
+		return errors.New("labor entry cannot end in the future")
+	}
+
+	entry.ID = utils.GenerateUUID()
+	entry.EndedAt = &endedAt
+	entry.Manual = true
+	entry.HourlyRate = user.HourlyRate
+	if err := s.repo.CreateLaborEntry(entry); err != nil {
+		return err
+	}
+
+	created, err := s.repo.GetLaborEntryByID(entry.ID)
+	if err != nil {
+		return err
+	}
+	*entry = *created
+	return nil
+}
+
+func (s *laborService) GetLaborEntries(recordID string) ([]models.LaborEntry, error) {
+	if _, err := s.findRecord(recordID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetLaborEntriesByRecord(recordID)
+}
+
+// DeleteLaborEntry removes a labor entry. Only admins may remove other
+// users' entries.
+func (s *laborService) DeleteLaborEntry(recordID, entryID, actorID, actorRole string) error {
+	entry, err := s.repo.GetLaborEntryByID(entryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("labor entry not found")
+		}
+		return err
+	}
+	if entry.RecordID != recordID {
+		return errors.New("labor entry not found")
+	}
+	if entry.UserID != actorID && actorRole != consts.RoleSuperUser && actorRole != consts.RoleAdmin {
+		return errors.New("access to labor entry denied")
+	}
+	return s.repo.DeleteLaborEntry(entryID)
+}
+
+// GetLaborTotals sums the finished labor entries started in [from, to) per
+// record, asset or technician. Running timers are left out until stopped.
+func (s *laborService) GetLaborTotals(groupBy string, from, to time.Time) ([]repositories.LaborTotal, error) {
+	if !to.After(from) {
+		return nil, errors.New("report start must be before its end")
+	}
+	if to.Sub(from) > maxLaborReportWindow {
+		return nil, errors.New("report range is too long")
+	}
+	return s.repo.GetLaborTotals(groupBy, from, to)
+}
+
+func (s *laborService) findUser(userID string) (*models.User, error) {
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user not found")
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *laborService) findRecord(recordID string) (*models.MaintenanceRecord, error) {
+	record, err := s.recordRepo.GetMaintenanceRecordByID(recordID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("maintenance record not found")
+		}
+		return nil, err
+	}
+	return record, nil
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"jaga/repositories"
+)
+
+// Leadership reports whether this instance may run cluster-wide background
+// work.
+type Leadership interface {
+	IsLeader() bool
+}
+
+// LeaderElector holds a database lease while it is the leader and renews it on
+// a heartbeat. When the leader stops renewing, another replica takes the lease
+// over once it has expired.
+type LeaderElector struct {
+	repo   repositories.SchedulerLeaseRepository
+	name   string
+	holder string
+	ttl    time.Duration
+	clock  Clock
+
+	mu         sync.RWMutex
+	validUntil time.Time
+
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func NewLeaderElector(repo repositories.SchedulerLeaseRepository, name, holder string, ttl time.Duration, clock Clock) *LeaderElector {
+	if clock == nil {
+		clock = SystemClock()
+	}
+	return &LeaderElector{
+		repo:   repo,
+		name:   name,
+		holder: holder,
+		ttl:    ttl,
+		clock:  clock,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+// Start makes a first attempt at the lease before returning, then keeps
+// renewing it every third of the lease duration.
+func (e *LeaderElector) Start() {
+	e.heartbeat()
+
+	go func() {
+		defer close(e.done)
+
+		ticker := time.NewTicker(e.ttl / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				e.heartbeat()
+			case <-e.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop ends the heartbeat and gives the lease up so another replica can take
+// over without waiting for it to expire.
+func (e *LeaderElector) Stop() {
+	e.stopOnce.Do(func() {
+		close(e.stop)
+	})
+	<-e.done
+
+	if e.IsLeader() {
+		if err := e.repo.ReleaseLease(e.name, e.holder); err != nil {
+			log.Printf("Failed to release lease %s: %v", e.name, err)
+		}
+	}
+	e.mu.Lock()
+	e.validUntil = time.Time{}
+	e.mu.Unlock()
+}
+
+// IsLeader is true while the last successful renewal is younger than the
+// lease, so a replica cut off from the database stops acting as leader before
+// anyone else can take over.
+func (e *LeaderElector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.clock.Now().Before(e.validUntil)
+}
+
+func (e *LeaderElector) heartbeat() {
+	startedAt := e.clock.Now()
+	wasLeader := e.IsLeader()
+
+	acquired, err := e.repo.AcquireLease(e.name, e.holder, e.ttl)
+	if err != nil {
+		log.Printf("Failed to renew lease %s: %v", e.name, err)
+		return
+	}
+
+	e.mu.Lock()
+	if acquired {
+		e.validUntil = startedAt.Add(e.ttl)
+	} else {
+		e.validUntil = time.Time{}
+	}
+	e.mu.Unlock()
+
+	if acquired && !wasLeader {
+		log.Printf("Instance %s acquired lease %s", e.holder, e.name)
+	} else if !acquired && wasLeader {
+		log.Printf("Instance %s lost lease %s", e.holder, e.name)
+	}
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+)
+
+// maxCalendarWindow is the longest span a single calendar request may cover.
+const maxCalendarWindow = 366 * 24 * time.Hour
+
+// CalendarEntry is one maintenance occurrence in the calendar: either a
+// planned, missed or paused occurrence of a schedule without a record yet, or
+// an existing maintenance record. ShiftedFrom is the nominal due date of an
+// occurrence that was moved off a closed day.
+type CalendarEntry struct {
+	Date        time.Time
+	ShiftedFrom *time.Time
+	State       string
+	AssetID     string
+	AssetName   string
+	AssignedTo  *string
+	Schedule    *models.MaintenanceSchedule
+	Record      *models.MaintenanceRecord
+}
+
+type MaintenanceCalendarService interface {
+	GetMaintenanceCalendar(from, to time.Time, assetID, assignedTo string, now time.Time) ([]CalendarEntry, error)
+}
+
+type maintenanceCalendarService struct {
+	scheduleRepo repositories.MaintenanceScheduleRepository
+	recordRepo   repositories.MaintenanceRecordRepository
+	holidayRepo  repositories.HolidayCalendarRepository
+}
+
+func NewMaintenanceCalendarService(
+	scheduleRepo repositories.MaintenanceScheduleRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+	holidayRepo repositories.HolidayCalendarRepository,
+) MaintenanceCalendarService {
+	return &maintenanceCalendarService{scheduleRepo: scheduleRepo, recordRepo: recordRepo, holidayRepo: holidayRepo}
+}
+
+func (s *maintenanceCalendarService) GetMaintenanceCalendar(from, to time.Time, assetID, assignedTo string, now time.Time) ([]CalendarEntry, error) {
+	if to.Before(from) {
+		return nil, errors.New("calendar start must be before its end")
+	}
+	if to.Sub(from) > maxCalendarWindow {
+		return nil, errors.New("calendar window cannot exceed 366 days")
+	}
+
+	records, err := s.recordRepo.GetMaintenanceRecordsInRange(from, to, assetID, assignedTo)
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := s.scheduleRepo.GetMaintenanceSchedulesForCalendar(assetID, assignedTo)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []CalendarEntry
+	recorded := map[string]bool{}
+
+	for i := range records {
+		record := &records[i]
+		// Generated records are planned on the effective date and keep the
+		// nominal occurrence in DueAt.
+		date := record.MaintenanceDate
+		if record.DueAt != nil && record.ScheduleID != nil {
+			recorded[occurrenceKey(*record.ScheduleID, *record.DueAt)] = true
+		}
+		if date.Before(from) || date.After(to) {
+			continue
+		}
+
+		entries = append(entries, CalendarEntry{
+			Date:       date,
+			State:      recordCalendarState(record.Status),
+			AssetID:    record.AssetID,
+			AssetName:  record.Asset.Name,
+			AssignedTo: record.PerformedBy,
+			Record:     record,
+		})
+	}
+
+	resolver := newClosedDayResolver(s.holidayRepo)
+	for i := range schedules {
+		schedule := &schedules[i]
+		// Occurrences just outside the window may be shifted into it.
+		occurrences, err := occurrencesBetween(schedule, from.Add(-maxClosedDayShift), to.Add(maxClosedDayShift))
+		if err != nil {
+			continue
+		}
+
+		var assignedTo *string
+		if schedule.AssignedTo != "" {
+			assignedTo = &schedule.AssignedTo
+		}
+
+		for _, occurrence := range occurrences {
+			if recorded[occurrenceKey(schedule.ID, occurrence)] {
+				continue
+			}
+
+			date, err := resolver.effectiveDate(schedule, occurrence)
+			if err != nil {
+				return nil, err
+			}
+			if date.Before(from) || date.After(to) {
+				continue
+			}
+
+			state := consts.CalendarStatePlanned
+			if isSuspendedOn(schedule, date) {
+				state = consts.CalendarStatePaused
+			} else if date.Before(now) {
+				state = consts.CalendarStateMissed
+			}
+
+			var shiftedFrom *time.Time
+			if !date.Equal(occurrence) {
+				nominal := occurrence
+				shiftedFrom = &nominal
+			}
+
+			entries = append(entries, CalendarEntry{
+				Date:        date,
+				ShiftedFrom: shiftedFrom,
+				State:       state,
+				AssetID:     schedule.AssetID,
+				AssetName:   schedule.Asset.Name,
+				AssignedTo:  assignedTo,
+				Schedule:    schedule,
+			})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date.Before(entries[j].Date)
+	})
+	return entries, nil
+}
+
+func occurrenceKey(scheduleID string, dueAt time.Time) string {
+	return fmt.Sprintf("%s|%d", scheduleID, dueAt.Unix())
+}
+
+func recordCalendarState(status string) string {
+	switch status {
+	case consts.RecordStatusFinished:
+		return consts.CalendarStateDone
+	case consts.RecordStatusFailed, consts.RecordStatusCancelled:
+		return consts.CalendarStateMissed
+	default:
+		return consts.CalendarStateGenerated
+	}
+}
@@ -2,27 +2,42 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"jaga/consts"
 	"jaga/models"
 	"jaga/repositories"
 	"jaga/utils"
+	"time"
 
 	"gorm.io/gorm"
 )
 
 type MaintenanceRecordService interface {
-	CreateMaintenanceRecord(record *models.MaintenanceRecord) error
+	CreateMaintenanceRecord(record *models.MaintenanceRecord, actorID string) error
+	CreateMaintenanceRecordTx(tx *gorm.DB, record *models.MaintenanceRecord, actorID string) error
 	GetMaintenanceRecordByID(recordID string) (*models.MaintenanceRecord, error)
-	GetMaintenanceRecords(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleID, status string) ([]models.MaintenanceRecord, int64, error)
-	UpdateMaintenanceRecord(record *models.MaintenanceRecord) error
-	UpdateMaintenanceRecordStatus(recordID, status string) error
+	GetMaintenanceRecords(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleID, status, performedBy string) ([]models.MaintenanceRecord, int64, error)
+	UpdateMaintenanceRecord(record *models.MaintenanceRecord, actorID string) error
+	UpdateMaintenanceRecordStatus(recordID, status, actorID, actorRole, comment string) error
+	ApproveMaintenanceRecord(recordID, actorID, comment string) error
+	RejectMaintenanceRecord(recordID, actorID, reason string) error
+	GetMaintenanceRecordHistory(recordID string) ([]models.MaintenanceRecordStatusChange, error)
 	DeleteMaintenanceRecord(recordID string) error
 }
 
 type maintenanceRecordService struct {
-	repo         repositories.MaintenanceRecordRepository
-	assetRepo    repositories.AssetRepository
-	scheduleRepo repositories.MaintenanceScheduleRepository
-	userRepo     repositories.UserRepository
+	repo             repositories.MaintenanceRecordRepository
+	assetRepo        repositories.AssetRepository
+	scheduleRepo     repositories.MaintenanceScheduleRepository
+	userRepo         repositories.UserRepository
+	checklistRepo    repositories.ChecklistRepository
+	partRepo         repositories.PartRepository
+	laborRepo        repositories.LaborEntryRepository
+	approvalRepo     repositories.ApprovalRepository
+	slaRepo          repositories.SLARepository
+	notificationRepo repositories.NotificationRepository
+	statuses         *RecordStatusMachine
+	txManager        repositories.TxManager
 }
 
 func NewMaintenanceRecordService(
@@ -30,17 +45,45 @@ func NewMaintenanceRecordService(
 	assetRepo repositories.AssetRepository,
 	scheduleRepo repositories.MaintenanceScheduleRepository,
 	userRepo repositories.UserRepository,
+	checklistRepo repositories.ChecklistRepository,
+	partRepo repositories.PartRepository,
+	laborRepo repositories.LaborEntryRepository,
+	approvalRepo repositories.ApprovalRepository,
+	slaRepo repositories.SLARepository,
+	notificationRepo repositories.NotificationRepository,
+	statuses *RecordStatusMachine,
+	txManager repositories.TxManager,
 ) MaintenanceRecordService {
 	return &maintenanceRecordService{
-		repo:         repo,
-		assetRepo:    assetRepo,
-		scheduleRepo: scheduleRepo,
-		userRepo:     userRepo,
+		repo:             repo,
+		assetRepo:        assetRepo,
+		scheduleRepo:     scheduleRepo,
+		userRepo:         userRepo,
+		checklistRepo:    checklistRepo,
+		partRepo:         partRepo,
+		laborRepo:        laborRepo,
+		approvalRepo:     approvalRepo,
+		slaRepo:          slaRepo,
+		notificationRepo: notificationRepo,
+		statuses:         statuses,
+		txManager:        txManager,
 	}
 }
 
-func (s *maintenanceRecordService) CreateMaintenanceRecord(record *models.MaintenanceRecord) error {
-	_, err := s.assetRepo.GetAssetByID(record.AssetID)
+func (s *maintenanceRecordService) CreateMaintenanceRecord(record *models.MaintenanceRecord, actorID string) error {
+	return s.txManager.Transaction(func(tx *gorm.DB) error {
+		return s.CreateMaintenanceRecordTx(tx, record, actorID)
+	})
+}
+
+// CreateMaintenanceRecordTx creates a record as part of the caller's
+// transaction, so that other services can create one together with their own
+// changes. The record is created in the status it is given, so that past work
+// can be logged as finished or failed; the status machine governs the changes
+// that follow. The initial status opens the record's history, attributed to
+// actorID, which is empty for records the system generates.
+func (s *maintenanceRecordService) CreateMaintenanceRecordTx(tx *gorm.DB, record *models.MaintenanceRecord, actorID string) error {
+	asset, err := s.assetRepo.WithTx(tx).GetAssetByID(record.AssetID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return errors.New("asset not found")
@@ -49,7 +92,7 @@ func (s *maintenanceRecordService) CreateMaintenanceRecord(record *models.Mainte
 	}
 
 	if record.ScheduleID != nil && *record.ScheduleID != "" {
-		_, err := s.scheduleRepo.GetMaintenanceScheduleByID(*record.ScheduleID)
+		_, err := s.scheduleRepo.WithTx(tx).GetMaintenanceScheduleByID(*record.ScheduleID)
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				return errors.New("maintenance schedule not found")
@@ -71,8 +114,37 @@ func (s *maintenanceRecordService) CreateMaintenanceRecord(record *models.Mainte
 	if record.ID == "" {
 		record.ID = utils.GenerateUUID()
 	}
+	if record.Priority == "" {
+		record.Priority = consts.PriorityMedium
+	}
+	now := time.Now()
+	if err := applySLAPolicy(s.slaRepo, record, now); err != nil {
+		return err
+	}
+	trackSLA(record, now)
 
-	return s.repo.CreateMaintenanceRecord(record)
+	repo := s.repo.WithTx(tx)
+	if err := repo.CreateMaintenanceRecord(record); err != nil {
+		return err
+	}
+	var changedBy *string
+	if actorID != "" {
+		changedBy = &actorID
+	}
+	err = repo.CreateStatusChange(&models.MaintenanceRecordStatusChange{
+		ID:        utils.GenerateUUID(),
+		RecordID:  record.ID,
+		ToStatus:  record.Status,
+		ChangedBy: changedBy,
+		ChangedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	if err := copyChecklist(s.checklistRepo.WithTx(tx), record, asset.CategoryID); err != nil {
+		return err
+	}
+	return s.syncAssetStatus(tx, record, "")
 }
 
 func (s *maintenanceRecordService) GetMaintenanceRecordByID(recordID string) (*models.MaintenanceRecord, error) {
@@ -86,16 +158,25 @@ func (s *maintenanceRecordService) GetMaintenanceRecordByID(recordID string) (*m
 	return record, nil
 }
 
-func (s *maintenanceRecordService) GetMaintenanceRecords(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleID, status string) ([]models.MaintenanceRecord, int64, error) {
-	records, totalItems, err := s.repo.GetMaintenanceRecords(page, itemsPerPage, sortBy, sortDir, assetID, scheduleID, status)
+func (s *maintenanceRecordService) GetMaintenanceRecords(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleID, status, performedBy string) ([]models.MaintenanceRecord, int64, error) {
+	var scheduleIDs []string
+	if scheduleID != "" {
+		scheduleIDs = append(scheduleIDs, scheduleID)
+	}
+
+	records, totalItems, err := s.repo.GetMaintenanceRecords(page, itemsPerPage, sortBy, sortDir, assetID, status, performedBy, scheduleIDs...)
 	if err != nil {
 		return nil, 0, err
 	}
 	return records, totalItems, nil
 }
 
-func (s *maintenanceRecordService) UpdateMaintenanceRecord(record *models.MaintenanceRecord) error {
-	_, err := s.repo.GetMaintenanceRecordByID(record.ID)
+// UpdateMaintenanceRecord applies a partial update: fields left empty on
+// record keep their stored values, so that the SLA deadlines, the response
+// time and the escalations, which the update form does not carry, survive an
+// edit along with the scheduled occurrence.
+func (s *maintenanceRecordService) UpdateMaintenanceRecord(record *models.MaintenanceRecord, actorID string) error {
+	existing, err := s.repo.GetMaintenanceRecordByID(record.ID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return errors.New("maintenance record not found")
@@ -121,8 +202,6 @@ func (s *maintenanceRecordService) UpdateMaintenanceRecord(record *models.Mainte
 			}
 			return err
 		}
-	} else if record.ScheduleID != nil && *record.ScheduleID == "" {
-
 	}
 
 	if record.PerformedBy != nil && *record.PerformedBy != "" {
@@ -133,14 +212,74 @@ func (s *maintenanceRecordService) UpdateMaintenanceRecord(record *models.Mainte
 			}
 			return err
 		}
-	} else if record.PerformedBy != nil && *record.PerformedBy == "" {
+	}
+
+	if existing.Status == consts.RecordStatusAwaitingApproval && record.Status == consts.RecordStatusFinished {
+		return errors.New("maintenance record is awaiting approval")
+	}
+	if record.Status == consts.RecordStatusAwaitingApproval && existing.Status != consts.RecordStatusAwaitingApproval {
+		return errors.New("invalid status transition")
+	}
+	if record.Status != "" && !s.statuses.CanTransition(existing.Status, record.Status) {
+		return errors.New("invalid status transition")
+	}
 
+	previousStatus := existing.Status
+	previousPriority := existing.Priority
+	previousDate := existing.MaintenanceDate
+	mergeMaintenanceRecord(existing, record)
+	if existing.Priority != previousPriority || !existing.MaintenanceDate.Equal(previousDate) {
+		if err := applySLAPolicy(s.slaRepo, existing, existing.CreatedAt); err != nil {
+			return err
+		}
 	}
 
-	return s.repo.UpdateMaintenanceRecord(record)
+	return s.saveMaintenanceRecord(existing, previousStatus, actorID, "")
+}
+
+// mergeMaintenanceRecord copies the fields set on update onto existing so a
+// partial update keeps the columns the caller left out. An empty schedule or
+// performer ID clears the link.
+func mergeMaintenanceRecord(existing, update *models.MaintenanceRecord) {
+	if update.AssetID != "" && update.AssetID != existing.AssetID {
+		existing.AssetID = update.AssetID
+		existing.Asset = models.Asset{}
+	}
+	if update.ScheduleID != nil {
+		if *update.ScheduleID == "" {
+			existing.ScheduleID = nil
+			existing.DueAt = nil
+		} else {
+			existing.ScheduleID = update.ScheduleID
+		}
+	}
+	if update.PerformedBy != nil {
+		if *update.PerformedBy == "" {
+			existing.PerformedBy = nil
+		} else {
+			existing.PerformedBy = update.PerformedBy
+		}
+	}
+	if update.Description != "" {
+		existing.Description = update.Description
+	}
+	if update.Status != "" {
+		existing.Status = update.Status
+	}
+	if update.Priority != "" {
+		existing.Priority = update.Priority
+	}
+	if !update.MaintenanceDate.IsZero() {
+		existing.MaintenanceDate = update.MaintenanceDate
+	}
 }
 
-func (s *maintenanceRecordService) UpdateMaintenanceRecordStatus(recordID, status string) error {
+// UpdateMaintenanceRecordStatus changes a record's status. Technicians may
+// only change records assigned to them; changing an unassigned record claims
+// it for the technician. A technician finishing a record whose category rule
+// asks for sign-off puts it in awaiting_approval instead, and a record awaiting
+// approval can only be finished by approving it.
+func (s *maintenanceRecordService) UpdateMaintenanceRecordStatus(recordID, status, actorID, actorRole, comment string) error {
 	record, err := s.repo.GetMaintenanceRecordByID(recordID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -149,9 +288,389 @@ func (s *maintenanceRecordService) UpdateMaintenanceRecordStatus(recordID, statu
 		return err
 	}
 
+	if err := checkRecordOwnership(record, actorID, actorRole); err != nil {
+		return err
+	}
+	if actorRole == consts.RoleTechnician {
+		record.PerformedBy = &actorID
+	}
+
+	previousStatus := record.Status
+	if previousStatus == consts.RecordStatusAwaitingApproval && status == consts.RecordStatusFinished {
+		return errors.New("maintenance record is awaiting approval")
+	}
+	// Only the approval rule puts a record in awaiting_approval, when a
+	// technician finishes it; nobody may request that status directly.
+	if status == consts.RecordStatusAwaitingApproval {
+		return errors.New("invalid status transition")
+	}
+	if previousStatus == consts.RecordStatusFinished && actorRole == consts.RoleTechnician {
+		return errors.New("only admins can reverse a finished maintenance record")
+	}
+	if status == consts.RecordStatusFinished && actorRole == consts.RoleTechnician {
+		required, err := s.requiresApproval(record)
+		if err != nil {
+			return err
+		}
+		if required {
+			status = consts.RecordStatusAwaitingApproval
+		}
+	}
+	if !s.statuses.CanTransition(previousStatus, status) {
+		return errors.New("invalid status transition")
+	}
+	record.Status = status
+
+	return s.saveMaintenanceRecord(record, previousStatus, actorID, comment)
+}
+
+// ApproveMaintenanceRecord signs off a record awaiting approval. The record
+// finishes with the usual effects and the technician who did the work is
+// notified. Nobody may approve their own work.
+func (s *maintenanceRecordService) ApproveMaintenanceRecord(recordID, actorID, comment string) error {
+	return s.decideApproval(recordID, actorID, consts.ApprovalDecisionApproved, comment)
+}
+
+// RejectMaintenanceRecord sends a record awaiting approval back to
+// in_progress and tells the technician why.
+func (s *maintenanceRecordService) RejectMaintenanceRecord(recordID, actorID, reason string) error {
+	return s.decideApproval(recordID, actorID, consts.ApprovalDecisionRejected, reason)
+}
+
+func (s *maintenanceRecordService) decideApproval(recordID, actorID, decision, reason string) error {
+	record, err := s.GetMaintenanceRecordByID(recordID)
+	if err != nil {
+		return err
+	}
+	if record.Status != consts.RecordStatusAwaitingApproval {
+		return errors.New("maintenance record is not awaiting approval")
+	}
+
+	status := consts.RecordStatusInProgress
+	notificationType := consts.NotificationTypeApprovalRejected
+	message := fmt.Sprintf("Your work on %s was rejected: %s", record.Asset.Name, reason)
+	if decision == consts.ApprovalDecisionApproved {
+		if record.PerformedBy != nil && *record.PerformedBy == actorID {
+			return errors.New("maintenance record cannot be approved by its performer")
+		}
+		status = consts.RecordStatusFinished
+		notificationType = consts.NotificationTypeApprovalApproved
+		message = fmt.Sprintf("Your work on %s was approved", record.Asset.Name)
+	}
+	if !s.statuses.CanTransition(record.Status, status) {
+		return errors.New("invalid status transition")
+	}
+
+	previousStatus := record.Status
 	record.Status = status
+	now := time.Now()
+	return s.txManager.Transaction(func(tx *gorm.DB) error {
+		if err := s.saveMaintenanceRecordTx(tx, record, previousStatus, actorID, reason, now); err != nil {
+			return err
+		}
+		err := s.approvalRepo.WithTx(tx).CreateRecordApproval(&models.RecordApproval{
+			ID:        utils.GenerateUUID(),
+			RecordID:  record.ID,
+			Decision:  decision,
+			Reason:    reason,
+			DecidedBy: actorID,
+			DecidedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+
+		if record.PerformedBy == nil || *record.PerformedBy == "" || *record.PerformedBy == actorID {
+			return nil
+		}
+		return s.notificationRepo.WithTx(tx).CreateNotifications([]models.Notification{{
+			ID:           utils.GenerateUUID(),
+			UserID:       *record.PerformedBy,
+			Type:         notificationType,
+			Message:      truncate(message, 500),
+			ResourceType: consts.NotificationResourceRecord,
+			ResourceID:   record.ID,
+			ActorID:      &actorID,
+		}})
+	})
+}
+
+// requiresApproval applies the approval rule of the record's asset category.
+// Categories without a rule need no sign-off.
+func (s *maintenanceRecordService) requiresApproval(record *models.MaintenanceRecord) (bool, error) {
+	rule, err := s.approvalRepo.GetCategoryApprovalRule(record.Asset.CategoryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if !rule.RequiresApproval {
+		return false, nil
+	}
+	return !rule.UnscheduledOnly || record.ScheduleID == nil || *record.ScheduleID == "", nil
+}
+
+// notifyApprovers tells every manager that a record awaits their approval.
+func (s *maintenanceRecordService) notifyApprovers(tx *gorm.DB, record *models.MaintenanceRecord, actorID string) error {
+	managers, err := s.userRepo.GetUsersByRole(consts.RoleManager)
+	if err != nil {
+		return err
+	}
+
+	var actor *string
+	if actorID != "" {
+		actor = &actorID
+	}
+	notifications := make([]models.Notification, 0, len(managers))
+	for _, manager := range managers {
+		if manager.ID == actorID {
+			continue
+		}
+		notifications = append(notifications, models.Notification{
+			ID:           utils.GenerateUUID(),
+			UserID:       manager.ID,
+			Type:         consts.NotificationTypeApprovalRequested,
+			Message:      truncate(fmt.Sprintf("The maintenance record for %s is awaiting your approval", record.Asset.Name), 500),
+			ResourceType: consts.NotificationResourceRecord,
+			ResourceID:   record.ID,
+			ActorID:      actor,
+		})
+	}
+	return s.notificationRepo.WithTx(tx).CreateNotifications(notifications)
+}
+
+// checkRecordOwnership rejects technicians working on records assigned to
+// someone else. Unassigned records are open to every technician.
+func checkRecordOwnership(record *models.MaintenanceRecord, actorID, actorRole string) error {
+	if actorRole != consts.RoleTechnician {
+		return nil
+	}
+	if record.PerformedBy != nil && *record.PerformedBy != "" && *record.PerformedBy != actorID {
+		return errors.New("maintenance record is assigned to another technician")
+	}
+	return nil
+}
+
+// saveMaintenanceRecord saves a record, failing if its stored status is no
+// longer previousStatus, and, when its status changed, tracks its SLA response
+// and resolution, appends the change to the record's history, updates the
+// asset's status, stops the running labor timers once work is no longer in
+// progress, asks the managers for sign-off when it awaits approval, takes the
+// used parts out of stock and advances its schedule on finishing, and puts the
+// parts back and skips the scheduled occurrence on cancelling, all in one
+// transaction.
+func (s *maintenanceRecordService) saveMaintenanceRecord(record *models.MaintenanceRecord, previousStatus, actorID, comment string) error {
+	now := time.Now()
+	return s.txManager.Transaction(func(tx *gorm.DB) error {
+		return s.saveMaintenanceRecordTx(tx, record, previousStatus, actorID, comment, now)
+	})
+}
+
+func (s *maintenanceRecordService) saveMaintenanceRecordTx(tx *gorm.DB, record *models.MaintenanceRecord, previousStatus, actorID, comment string, now time.Time) error {
+	repo := s.repo.WithTx(tx)
+
+	// The status was checked before the transaction began; lock the row and
+	// check again so two concurrent changes cannot both apply.
+	current, err := repo.GetMaintenanceRecordByIDForUpdate(record.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("maintenance record not found")
+		}
+		return err
+	}
+	if current.Status != previousStatus ||
+		(record.Status != previousStatus && !s.statuses.CanTransition(previousStatus, record.Status)) {
+		return errors.New("invalid status transition")
+	}
+
+	if record.Status != previousStatus &&
+		(record.Status == consts.RecordStatusFinished || record.Status == consts.RecordStatusAwaitingApproval) {
+		incomplete, err := s.checklistRepo.WithTx(tx).CountIncompleteRequiredItems(record.ID)
+		if err != nil {
+			return err
+		}
+		if incomplete > 0 {
+			return errors.New("required checklist items are incomplete")
+		}
+	}
+
+	if record.Status != previousStatus {
+		trackSLA(record, now)
+	}
+
+	if err := repo.UpdateMaintenanceRecord(record); err != nil {
+		return err
+	}
+	if record.Status == previousStatus {
+		return nil
+	}
+
+	var changedBy *string
+	if actorID != "" {
+		changedBy = &actorID
+	}
+	err = repo.CreateStatusChange(&models.MaintenanceRecordStatusChange{
+		ID:         utils.GenerateUUID(),
+		RecordID:   record.ID,
+		FromStatus: previousStatus,
+		ToStatus:   record.Status,
+		ChangedBy:  changedBy,
+		Note:       comment,
+		ChangedAt:  now,
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.syncAssetStatus(tx, record, previousStatus); err != nil {
+		return err
+	}
+	if record.Status != consts.RecordStatusInProgress {
+		if err := s.laborRepo.WithTx(tx).StopRunningLaborEntries(record.ID, now); err != nil {
+			return err
+		}
+	}
+
+	switch record.Status {
+	case consts.RecordStatusAwaitingApproval:
+		return s.notifyApprovers(tx, record, actorID)
+	case consts.RecordStatusFinished:
+		if err := deductRecordParts(s.partRepo.WithTx(tx), record.ID, now); err != nil {
+			return err
+		}
+		return advanceSchedule(s.scheduleRepo.WithTx(tx), record, now)
+	case consts.RecordStatusCancelled:
+		if err := restoreRecordParts(s.partRepo.WithTx(tx), record.ID); err != nil {
+			return err
+		}
+		// A cancelled occurrence is skipped; otherwise its schedule would
+		// keep coming due on the same date. Finishing it already moved the
+		// schedule on.
+		if record.DueAt == nil || previousStatus == consts.RecordStatusFinished {
+			return nil
+		}
+		return advanceSchedule(s.scheduleRepo.WithTx(tx), record, now)
+	}
+	return nil
+}
+
+// syncAssetStatus follows a record's status change on its asset: work in
+// progress puts the asset under maintenance, a failed record leaves it needing
+// maintenance, and finishing the last open record makes it ready again, as does
+// cancelling it while the asset is under maintenance. A record on hold keeps
+// the asset under maintenance until work resumes or the record is closed. A
+// finished record also moves the asset's last maintenance date forward.
+func (s *maintenanceRecordService) syncAssetStatus(tx *gorm.DB, record *models.MaintenanceRecord, previousStatus string) error {
+	if record.Status == previousStatus {
+		return nil
+	}
+
+	var status, reason string
+	switch record.Status {
+	case consts.RecordStatusInProgress:
+		status = consts.AssetStatusUnderMaintenance
+		reason = fmt.Sprintf("Maintenance record %s in progress", record.ID)
+	case consts.RecordStatusFailed:
+		status = consts.AssetStatusNeedMaintenance
+		reason = fmt.Sprintf("Maintenance record %s failed", record.ID)
+	case consts.RecordStatusFinished:
+		status = consts.AssetStatusReady
+		reason = fmt.Sprintf("Maintenance record %s finished", record.ID)
+	case consts.RecordStatusCancelled:
+		status = consts.AssetStatusReady
+		reason = fmt.Sprintf("Maintenance record %s cancelled", record.ID)
+	default:
+		return nil
+	}
+
+	assetRepo := s.assetRepo.WithTx(tx)
+	// Lock the asset so two records finishing at once cannot both see the
+	// other as still open.
+	asset, err := assetRepo.GetAssetByIDForUpdate(record.AssetID)
+	if err != nil {
+		return err
+	}
+
+	if record.Status == consts.RecordStatusFinished {
+		if asset.LastMaintenanceDate == nil || asset.LastMaintenanceDate.Before(record.MaintenanceDate) {
+			if err := assetRepo.UpdateLastMaintenanceDate(asset.ID, record.MaintenanceDate); err != nil {
+				return err
+			}
+		}
+	}
+	if record.Status == consts.RecordStatusCancelled && asset.Status != consts.AssetStatusUnderMaintenance {
+		// Leave assets flagged for other reasons, e.g. an overdue schedule,
+		// as they are.
+		return nil
+	}
+	if status == consts.AssetStatusReady {
+		hasOpen, err := s.repo.WithTx(tx).HasOtherOpenMaintenanceRecords(asset.ID, record.ID)
+		if err != nil {
+			return err
+		}
+		if hasOpen {
+			return nil
+		}
+	}
+
+	if asset.Status == status {
+		return nil
+	}
+	return assetRepo.UpdateAssetStatus(asset.ID, status, reason)
+}
+
+func (s *maintenanceRecordService) GetMaintenanceRecordHistory(recordID string) ([]models.MaintenanceRecordStatusChange, error) {
+	if _, err := s.GetMaintenanceRecordByID(recordID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetStatusChanges(recordID)
+}
+
+// advanceSchedule moves the periodic schedule behind a finished record on to
+// its next due date.
+func advanceSchedule(scheduleRepo repositories.MaintenanceScheduleRepository, record *models.MaintenanceRecord, completedAt time.Time) error {
+	if record.ScheduleID == nil || *record.ScheduleID == "" {
+		return nil
+	}
+
+	schedule, err := scheduleRepo.GetMaintenanceScheduleByID(*record.ScheduleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	dueDate := record.MaintenanceDate
+	if record.DueAt != nil {
+		dueDate = *record.DueAt
+	}
+
+	next, ok := nextMaintenanceDate(schedule, dueDate, completedAt)
+	if !ok {
+		return endRecurrence(scheduleRepo, schedule, completedAt)
+	}
+	if !next.After(schedule.NextMaintenanceDate) {
+		return nil
+	}
+
+	schedule.NextMaintenanceDate = next
+	return scheduleRepo.UpdateMaintenanceSchedule(schedule)
+}
+
+// endRecurrence suspends a schedule whose recurrence rule has no occurrence
+// left, e.g. once its COUNT or UNTIL is used up, so that the due schedule job
+// stops selecting it. Schedules without a rule are left as they are.
+func endRecurrence(scheduleRepo repositories.MaintenanceScheduleRepository, schedule *models.MaintenanceSchedule, now time.Time) error {
+	if schedule.RecurrenceRule == nil || *schedule.RecurrenceRule == "" || schedule.SuspendedAt != nil {
+		return nil
+	}
 
-	return s.repo.UpdateMaintenanceRecord(record)
+	schedule.SuspendedAt = &now
+	schedule.SuspendedUntil = nil
+	schedule.SuspendedBy = nil
+	schedule.SuspendReason = "Recurrence rule has no further occurrences"
+	return scheduleRepo.UpdateMaintenanceSchedule(schedule)
 }
 
 func (s *maintenanceRecordService) DeleteMaintenanceRecord(recordID string) error {
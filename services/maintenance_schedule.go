@@ -2,6 +2,7 @@ package services
 
 import (
 	"errors"
+	"jaga/consts"
 	"jaga/models"
 	"jaga/repositories"
 	"jaga/utils"
@@ -13,22 +14,45 @@ import (
 type MaintenanceScheduleService interface {
 	CreateMaintenanceSchedule(schedule *models.MaintenanceSchedule) error
 	GetMaintenanceScheduleByID(scheduleID string) (*models.MaintenanceSchedule, error)
-	GetMaintenanceSchedules(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleType string, startDate, endDate *time.Time) ([]models.MaintenanceSchedule, int64, error)
+	GetMaintenanceSchedules(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleType, assignedTo string, startDate, endDate *time.Time) ([]models.MaintenanceSchedule, int64, error)
+	GetOverdueMaintenanceSchedules(now time.Time) ([]OverdueSchedule, error)
+	GetUpcomingOccurrences(scheduleID string, count int) ([]time.Time, error)
+	PreviewRecurrenceRule(rule string, start time.Time, count int) ([]time.Time, error)
 	UpdateMaintenanceSchedule(schedule *models.MaintenanceSchedule) error
+	SuspendMaintenanceSchedule(scheduleID, suspendedBy, reason string, until *time.Time, resumePolicy string, now time.Time) error
+	ResumeMaintenanceSchedule(scheduleID, policy string, now time.Time) error
 	DeleteMaintenanceSchedule(scheduleID string) error
 }
 
 type maintenanceScheduleService struct {
-	repo      repositories.MaintenanceScheduleRepository
-	assetRepo repositories.AssetRepository
+	repo        repositories.MaintenanceScheduleRepository
+	assetRepo   repositories.AssetRepository
+	meterRepo   repositories.AssetMeterRepository
+	userRepo    repositories.UserRepository
+	holidayRepo repositories.HolidayCalendarRepository
+	assigner    AssignmentService
 }
 
-func NewMaintenanceScheduleService(repo repositories.MaintenanceScheduleRepository, assetRepo repositories.AssetRepository) MaintenanceScheduleService {
-	return &maintenanceScheduleService{repo: repo, assetRepo: assetRepo}
+func NewMaintenanceScheduleService(
+	repo repositories.MaintenanceScheduleRepository,
+	assetRepo repositories.AssetRepository,
+	meterRepo repositories.AssetMeterRepository,
+	userRepo repositories.UserRepository,
+	holidayRepo repositories.HolidayCalendarRepository,
+	assigner AssignmentService,
+) MaintenanceScheduleService {
+	return &maintenanceScheduleService{
+		repo:        repo,
+		assetRepo:   assetRepo,
+		meterRepo:   meterRepo,
+		userRepo:    userRepo,
+		holidayRepo: holidayRepo,
+		assigner:    assigner,
+	}
 }
 
 func (s *maintenanceScheduleService) CreateMaintenanceSchedule(schedule *models.MaintenanceSchedule) error {
-	_, err := s.assetRepo.GetAssetByID(schedule.AssetID)
+	asset, err := s.assetRepo.GetAssetByID(schedule.AssetID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return errors.New("asset not found")
@@ -36,9 +60,39 @@ func (s *maintenanceScheduleService) CreateMaintenanceSchedule(schedule *models.
 		return err
 	}
 
+	if schedule.AssignedTo != "" {
+		if err := s.assigner.ValidateTechnician(schedule.AssignedTo); err != nil {
+			return err
+		}
+	} else {
+		technician, err := s.assigner.PickTechnician(asset.CategoryID)
+		if err != nil {
+			return err
+		}
+		if technician != nil {
+			schedule.AssignedTo = technician.ID
+		}
+	}
+
+	if err := s.validateMeterCondition(schedule); err != nil {
+		return err
+	}
+	if err := applyRecurrenceRule(schedule, nil); err != nil {
+		return err
+	}
+	if err := s.validateHolidayCalendar(schedule); err != nil {
+		return err
+	}
+
 	if schedule.ID == "" {
 		schedule.ID = utils.GenerateUUID()
 	}
+	if schedule.AdvanceFrom == "" {
+		schedule.AdvanceFrom = consts.ScheduleAdvanceFromDueDate
+	}
+	if schedule.ClosedDayPolicy == "" {
+		schedule.ClosedDayPolicy = consts.ClosedDayPolicyShiftForward
+	}
 	return s.repo.CreateMaintenanceSchedule(schedule)
 }
 
@@ -53,9 +107,9 @@ func (s *maintenanceScheduleService) GetMaintenanceScheduleByID(scheduleID strin
 	return schedule, nil
 }
 
-func (s *maintenanceScheduleService) GetMaintenanceSchedules(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleType string, startDate, endDate *time.Time) ([]models.MaintenanceSchedule, int64, error) {
+func (s *maintenanceScheduleService) GetMaintenanceSchedules(page, itemsPerPage int, sortBy, sortDir, assetID, scheduleType, assignedTo string, startDate, endDate *time.Time) ([]models.MaintenanceSchedule, int64, error) {
 	schedules, totalItems, err := s.repo.GetMaintenanceSchedules(
-		page, itemsPerPage, sortBy, sortDir, assetID, scheduleType, nil, nil,
+		page, itemsPerPage, sortBy, sortDir, assetID, scheduleType, assignedTo, startDate, endDate,
 	)
 	if err != nil {
 		return nil, 0, err
@@ -65,7 +119,7 @@ func (s *maintenanceScheduleService) GetMaintenanceSchedules(page, itemsPerPage
 
 func (s *maintenanceScheduleService) UpdateMaintenanceSchedule(schedule *models.MaintenanceSchedule) error {
 
-	_, err := s.repo.GetMaintenanceScheduleByID(schedule.ID)
+	existing, err := s.repo.GetMaintenanceScheduleByID(schedule.ID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return errors.New("maintenance schedule not found")
@@ -83,7 +137,187 @@ func (s *maintenanceScheduleService) UpdateMaintenanceSchedule(schedule *models.
 		}
 	}
 
-	return s.repo.UpdateMaintenanceSchedule(schedule)
+	if schedule.AssignedTo != "" {
+		if err := s.assigner.ValidateTechnician(schedule.AssignedTo); err != nil {
+			return err
+		}
+	}
+
+	meterChanged := schedule.MeterID != nil || schedule.MeterInterval != nil || schedule.MeterThreshold != nil
+	ruleChanged := schedule.RecurrenceRule != nil || (existing.RecurrenceRule != nil && !schedule.NextMaintenanceDate.IsZero())
+	previousRule := existing.RecurrenceRule
+	if schedule.RecurrenceRule != nil && *schedule.RecurrenceRule != "" && schedule.IntervalDays == nil {
+		existing.IntervalDays = nil
+	}
+	mergeMaintenanceSchedule(existing, schedule)
+
+	if meterChanged {
+		if schedule.MeterThreshold == nil {
+			existing.MeterThreshold = nil
+		}
+		if err := s.validateMeterCondition(existing); err != nil {
+			return err
+		}
+	}
+
+	if ruleChanged {
+		if err := applyRecurrenceRule(existing, previousRule); err != nil {
+			return err
+		}
+	}
+
+	if schedule.HolidayCalendarID != nil {
+		if err := s.validateHolidayCalendar(existing); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.UpdateMaintenanceSchedule(existing)
+}
+
+func (s *maintenanceScheduleService) GetOverdueMaintenanceSchedules(now time.Time) ([]OverdueSchedule, error) {
+	overdue, err := findOverdueSchedules(s.repo, s.holidayRepo, now)
+	if err != nil {
+		return nil, err
+	}
+
+	technicians := map[string]*models.User{}
+	for i := range overdue {
+		schedule := overdue[i].Schedule
+		if schedule.AssignedTo == "" {
+			continue
+		}
+		technician, seen := technicians[schedule.AssignedTo]
+		if !seen {
+			technician, err = s.userRepo.GetUserByID(schedule.AssignedTo)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+			technicians[schedule.AssignedTo] = technician
+		}
+		overdue[i].Technician = technician
+	}
+
+	return overdue, nil
+}
+
+func (s *maintenanceScheduleService) GetUpcomingOccurrences(scheduleID string, count int) ([]time.Time, error) {
+	schedule, err := s.GetMaintenanceScheduleByID(scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := upcomingOccurrences(schedule, count)
+	if err != nil {
+		return nil, errors.New("invalid recurrence rule")
+	}
+	return occurrences, nil
+}
+
+func (s *maintenanceScheduleService) PreviewRecurrenceRule(rule string, start time.Time, count int) ([]time.Time, error) {
+	parsed, err := utils.ParseRRule(rule)
+	if err != nil {
+		return nil, errors.New("invalid recurrence rule")
+	}
+	return parsed.Next(start, start, count), nil
+}
+
+// mergeMaintenanceSchedule copies the fields set on update onto existing so a
+// partial update does not wipe columns the caller left out.
+func mergeMaintenanceSchedule(existing, update *models.MaintenanceSchedule) {
+	if update.AssetID != "" {
+		existing.AssetID = update.AssetID
+	}
+	if update.ScheduleType != "" {
+		existing.ScheduleType = update.ScheduleType
+	}
+	if update.RecurrenceRule != nil {
+		existing.RecurrenceRule = update.RecurrenceRule
+	}
+	if update.IntervalDays != nil {
+		existing.IntervalDays = update.IntervalDays
+	}
+	if update.MeterID != nil {
+		existing.MeterID = update.MeterID
+	}
+	if update.MeterInterval != nil {
+		existing.MeterInterval = update.MeterInterval
+	}
+	if update.MeterThreshold != nil {
+		existing.MeterThreshold = update.MeterThreshold
+	}
+	if update.AdvanceFrom != "" {
+		existing.AdvanceFrom = update.AdvanceFrom
+	}
+	if !update.NextMaintenanceDate.IsZero() {
+		existing.NextMaintenanceDate = update.NextMaintenanceDate
+	}
+	if update.HolidayCalendarID != nil {
+		existing.HolidayCalendarID = update.HolidayCalendarID
+	}
+	if update.ClosedDayPolicy != "" {
+		existing.ClosedDayPolicy = update.ClosedDayPolicy
+	}
+	if update.ScheduledBy != "" {
+		existing.ScheduledBy = update.ScheduledBy
+	}
+	if update.AssignedTo != "" {
+		existing.AssignedTo = update.AssignedTo
+	}
+}
+
+// validateMeterCondition checks the meter condition of a schedule and derives
+// its first threshold from the meter's current value when only an interval is
+// given.
+func (s *maintenanceScheduleService) validateMeterCondition(schedule *models.MaintenanceSchedule) error {
+	if schedule.MeterID == nil || *schedule.MeterID == "" {
+		schedule.MeterID = nil
+		schedule.MeterInterval = nil
+		schedule.MeterThreshold = nil
+		return nil
+	}
+
+	if schedule.ScheduleType != consts.ScheduleTypeConditional {
+		return errors.New("meter conditions require a conditional schedule")
+	}
+	if schedule.MeterThreshold == nil && schedule.MeterInterval == nil {
+		return errors.New("meter threshold or interval is required")
+	}
+
+	meter, err := s.meterRepo.GetAssetMeterByID(*schedule.MeterID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("meter not found")
+		}
+		return err
+	}
+	if meter.AssetID != schedule.AssetID {
+		return errors.New("meter not found")
+	}
+
+	if schedule.MeterThreshold == nil {
+		threshold := meter.CurrentValue + *schedule.MeterInterval
+		schedule.MeterThreshold = &threshold
+	}
+	return nil
+}
+
+// validateHolidayCalendar checks that the schedule's holiday calendar exists.
+// An empty calendar ID detaches the schedule from its calendar.
+func (s *maintenanceScheduleService) validateHolidayCalendar(schedule *models.MaintenanceSchedule) error {
+	if schedule.HolidayCalendarID == nil || *schedule.HolidayCalendarID == "" {
+		schedule.HolidayCalendarID = nil
+		return nil
+	}
+
+	_, err := s.holidayRepo.GetHolidayCalendarByID(*schedule.HolidayCalendarID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("holiday calendar not found")
+		}
+		return err
+	}
+	return nil
 }
 
 func (s *maintenanceScheduleService) DeleteMaintenanceSchedule(scheduleID string) error {
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"jaga/models"
+	"jaga/repositories"
+)
+
+type NotificationService interface {
+	GetNotifications(userID string, unreadOnly bool, page, itemsPerPage int) ([]models.Notification, int64, int64, error)
+	MarkNotificationRead(userID, notificationID string) error
+	MarkAllNotificationsRead(userID string) error
+}
+
+type notificationService struct {
+	repo repositories.NotificationRepository
+}
+
+func NewNotificationService(repo repositories.NotificationRepository) NotificationService {
+	return &notificationService{repo: repo}
+}
+
+// GetNotifications returns a page of the user's notifications, newest first,
+// with the total matching and the number still unread.
+func (s *notificationService) GetNotifications(userID string, unreadOnly bool, page, itemsPerPage int) ([]models.Notification, int64, int64, error) {
+	notifications, totalItems, err := s.repo.GetNotificationsByUser(userID, unreadOnly, page, itemsPerPage)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	unread, err := s.repo.CountUnreadNotifications(userID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return notifications, totalItems, unread, nil
+}
+
+func (s *notificationService) MarkNotificationRead(userID, notificationID string) error {
+	found, err := s.repo.MarkNotificationRead(userID, notificationID, time.Now())
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.New("notification not found")
+	}
+	return nil
+}
+
+func (s *notificationService) MarkAllNotificationsRead(userID string) error {
+	return s.repo.MarkAllNotificationsRead(userID, time.Now())
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+)
+
+// OverdueSchedule is a schedule whose due date has passed without a finished
+// record, with the technician it is assigned to when that user exists. DueDate
+// is the due date after applying the schedule's closed-day policy.
+type OverdueSchedule struct {
+	Schedule    models.MaintenanceSchedule
+	DueDate     time.Time
+	DaysOverdue int
+	Technician  *models.User
+}
+
+func daysOverdue(dueDate, now time.Time) int {
+	return int(now.Sub(dueDate).Hours() / 24)
+}
+
+// findOverdueSchedules returns the schedules whose effective due date lies
+// before now.
+func findOverdueSchedules(
+	scheduleRepo repositories.MaintenanceScheduleRepository,
+	holidayRepo repositories.HolidayCalendarRepository,
+	now time.Time,
+) ([]OverdueSchedule, error) {
+	schedules, err := scheduleRepo.GetOverdueMaintenanceSchedules(now.Add(maxClosedDayShift))
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := newClosedDayResolver(holidayRepo)
+	var overdue []OverdueSchedule
+	for _, schedule := range schedules {
+		dueDate, err := resolver.effectiveDate(&schedule, schedule.NextMaintenanceDate)
+		if err != nil {
+			return nil, err
+		}
+		if !dueDate.Before(now) {
+			continue
+		}
+		overdue = append(overdue, OverdueSchedule{
+			Schedule:    schedule,
+			DueDate:     dueDate,
+			DaysOverdue: daysOverdue(dueDate, now),
+		})
+	}
+	return overdue, nil
+}
+
+// OverdueSweepJob flags the assets of overdue schedules as needing
+// maintenance.
+type OverdueSweepJob struct {
+	scheduleRepo repositories.MaintenanceScheduleRepository
+	assetRepo    repositories.AssetRepository
+	holidayRepo  repositories.HolidayCalendarRepository
+}
+
+func NewOverdueSweepJob(
+	scheduleRepo repositories.MaintenanceScheduleRepository,
+	assetRepo repositories.AssetRepository,
+	holidayRepo repositories.HolidayCalendarRepository,
+) *OverdueSweepJob {
+	return &OverdueSweepJob{scheduleRepo: scheduleRepo, assetRepo: assetRepo, holidayRepo: holidayRepo}
+}
+
+func (j *OverdueSweepJob) Name() string {
+	return "overdue-sweep"
+}
+
+func (j *OverdueSweepJob) Run(now time.Time) error {
+	overdue, err := findOverdueSchedules(j.scheduleRepo, j.holidayRepo, now)
+	if err != nil {
+		return err
+	}
+
+	flagged := map[string]bool{}
+	for _, item := range overdue {
+		schedule := item.Schedule
+		// Assets already being worked on keep their status; assets flagged
+		// earlier keep their original reason.
+		if schedule.Asset.Status != consts.AssetStatusReady || flagged[schedule.AssetID] {
+			continue
+		}
+
+		reason := fmt.Sprintf("Maintenance schedule %s overdue since %s",
+			schedule.ID, item.DueDate.Format("2006-01-02"))
+		if err := j.assetRepo.UpdateAssetStatus(schedule.AssetID, consts.AssetStatusNeedMaintenance, reason); err != nil {
+			log.Printf("Failed to flag asset %s as needing maintenance: %v", schedule.AssetID, err)
+			continue
+		}
+		flagged[schedule.AssetID] = true
+	}
+
+	if len(flagged) > 0 {
+		log.Printf("Flagged %d asset(s) with overdue maintenance", len(flagged))
+	}
+	return nil
+}
@@ -0,0 +1,361 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+type PartService interface {
+	CreatePart(part *models.Part, categoryIDs []string) error
+	GetParts(page, itemsPerPage int, search, categoryID, actorID, actorRole string) ([]models.Part, int64, error)
+	GetPartByID(partID string) (*models.Part, error)
+	UpdatePart(part *models.Part, lowStockThreshold *float64, categoryIDs []string) error
+	DeletePart(partID string) error
+	SetPartStock(partID, locationID string, quantity float64) (*models.Part, error)
+	GetLowStockParts() ([]models.Part, error)
+	GetRecordParts(recordID, actorID, actorRole string) ([]models.RecordPart, error)
+	AddRecordPart(recordPart *models.RecordPart, actorRole string) error
+	RemoveRecordPart(recordID, recordPartID, actorID, actorRole string) error
+}
+
+type partService struct {
+	repo         repositories.PartRepository
+	locationRepo repositories.StockLocationRepository
+	categoryRepo repositories.AssetCategoryRepository
+	recordRepo   repositories.MaintenanceRecordRepository
+}
+
+func NewPartService(
+	repo repositories.PartRepository,
+	locationRepo repositories.StockLocationRepository,
+	categoryRepo repositories.AssetCategoryRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+) PartService {
+	return &partService{
+		repo:         repo,
+		locationRepo: locationRepo,
+		categoryRepo: categoryRepo,
+		recordRepo:   recordRepo,
+	}
+}
+
+func (s *partService) CreatePart(part *models.Part, categoryIDs []string) error {
+	categories, err := s.findCategories(categoryIDs)
+	if err != nil {
+		return err
+	}
+	part.Categories = categories
+
+	if part.ID == "" {
+		part.ID = utils.GenerateUUID()
+	}
+	if err := s.repo.CreatePart(part); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errors.New("part sku already exists")
+		}
+		return err
+	}
+	return nil
+}
+
+// GetParts lists parts. Technicians only see parts without categories and
+// parts for the asset categories of their open records.
+func (s *partService) GetParts(page, itemsPerPage int, search, categoryID, actorID, actorRole string) ([]models.Part, int64, error) {
+	var visibleCategoryIDs []string
+	if actorRole == consts.RoleTechnician {
+		records, err := s.recordRepo.GetOpenMaintenanceRecordsByPerformer(actorID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		visibleCategoryIDs = []string{}
+		seen := map[string]bool{}
+		for _, record := range records {
+			if !seen[record.Asset.CategoryID] {
+				seen[record.Asset.CategoryID] = true
+				visibleCategoryIDs = append(visibleCategoryIDs, record.Asset.CategoryID)
+			}
+		}
+	}
+	return s.repo.GetParts(page, itemsPerPage, search, categoryID, visibleCategoryIDs)
+}
+
+func (s *partService) GetPartByID(partID string) (*models.Part, error) {
+	part, err := s.repo.GetPartByID(partID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("part not found")
+		}
+		return nil, err
+	}
+	return part, nil
+}
+
+// UpdatePart updates the part's details. A nil lowStockThreshold or
+// categoryIDs keeps the current value; an empty categoryIDs makes the part fit
+// every asset.
+func (s *partService) UpdatePart(part *models.Part, lowStockThreshold *float64, categoryIDs []string) error {
+	existing, err := s.GetPartByID(part.ID)
+	if err != nil {
+		return err
+	}
+
+	if part.SKU != "" {
+		existing.SKU = part.SKU
+	}
+	if part.Name != "" {
+		existing.Name = part.Name
+	}
+	if part.Unit != "" {
+		existing.Unit = part.Unit
+	}
+	if part.Description != "" {
+		existing.Description = part.Description
+	}
+	if lowStockThreshold != nil {
+		existing.LowStockThreshold = *lowStockThreshold
+	}
+	if part.UnitCost != nil {
+		existing.UnitCost = part.UnitCost
+	}
+	if categoryIDs != nil {
+		categories, err := s.findCategories(categoryIDs)
+		if err != nil {
+			return err
+		}
+		existing.Categories = categories
+	}
+
+	if err := s.repo.UpdatePart(existing); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errors.New("part sku already exists")
+		}
+		return err
+	}
+	*part = *existing
+	return nil
+}
+
+// DeletePart deletes a part that was never used on a maintenance record,
+// together with its stock.
+func (s *partService) DeletePart(partID string) error {
+	if _, err := s.GetPartByID(partID); err != nil {
+		return err
+	}
+
+	inUse, err := s.repo.IsPartInUse(partID)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return errors.New("part is in use")
+	}
+	return s.repo.DeletePart(partID)
+}
+
+// SetPartStock records the counted quantity of a part at a location.
+func (s *partService) SetPartStock(partID, locationID string, quantity float64) (*models.Part, error) {
+	if _, err := s.GetPartByID(partID); err != nil {
+		return nil, err
+	}
+	if err := s.findLocation(locationID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SetPartStock(partID, locationID, quantity); err != nil {
+		return nil, err
+	}
+	return s.GetPartByID(partID)
+}
+
+func (s *partService) GetLowStockParts() ([]models.Part, error) {
+	return s.repo.GetLowStockParts()
+}
+
+func (s *partService) GetRecordParts(recordID, actorID, actorRole string) ([]models.RecordPart, error) {
+	record, err := s.findRecord(recordID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRecordOwnership(record, actorID, actorRole); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRecordParts(recordID)
+}
+
+// AddRecordPart records a part used on an open maintenance record at the
+// part's current unit cost. Stock is only taken when the record finishes.
+func (s *partService) AddRecordPart(recordPart *models.RecordPart, actorRole string) error {
+	record, err := s.findRecord(recordPart.RecordID)
+	if err != nil {
+		return err
+	}
+	if err := checkRecordOwnership(record, recordPart.AddedBy, actorRole); err != nil {
+		return err
+	}
+	if !isOpenRecordStatus(record.Status) {
+		return errors.New("maintenance record is closed")
+	}
+
+	part, err := s.GetPartByID(recordPart.PartID)
+	if err != nil {
+		return err
+	}
+	if !partFitsCategory(part, record.Asset.CategoryID) {
+		return errors.New("part does not fit the asset category")
+	}
+	if err := s.findLocation(recordPart.LocationID); err != nil {
+		return err
+	}
+
+	if recordPart.ID == "" {
+		recordPart.ID = utils.GenerateUUID()
+	}
+	recordPart.UnitCost = part.UnitCost
+	if err := s.repo.CreateRecordPart(recordPart); err != nil {
+		return err
+	}
+
+	created, err := s.repo.GetRecordPartByID(recordPart.ID)
+	if err != nil {
+		return err
+	}
+	*recordPart = *created
+	return nil
+}
+
+func (s *partService) RemoveRecordPart(recordID, recordPartID, actorID, actorRole string) error {
+	record, err := s.findRecord(recordID)
+	if err != nil {
+		return err
+	}
+	if err := checkRecordOwnership(record, actorID, actorRole); err != nil {
+		return err
+	}
+
+	recordPart, err := s.repo.GetRecordPartByID(recordPartID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("record part not found")
+		}
+		return err
+	}
+	if recordPart.RecordID != recordID {
+		return errors.New("record part not found")
+	}
+	if !isOpenRecordStatus(record.Status) || recordPart.DeductedAt != nil {
+		return errors.New("maintenance record is closed")
+	}
+	return s.repo.DeleteRecordPart(recordPartID)
+}
+
+func (s *partService) findCategories(categoryIDs []string) ([]models.AssetCategory, error) {
+	categories := make([]models.AssetCategory, 0, len(categoryIDs))
+	seen := map[string]bool{}
+	for _, categoryID := range categoryIDs {
+		if seen[categoryID] {
+			continue
+		}
+		seen[categoryID] = true
+
+		category, err := s.categoryRepo.GetAssetCategoryByID(categoryID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("asset category not found")
+			}
+			return nil, err
+		}
+		categories = append(categories, *category)
+	}
+	return categories, nil
+}
+
+func (s *partService) findLocation(locationID string) error {
+	if _, err := s.locationRepo.GetStockLocationByID(locationID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("stock location not found")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *partService) findRecord(recordID string) (*models.MaintenanceRecord, error) {
+	record, err := s.recordRepo.GetMaintenanceRecordByID(recordID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("maintenance record not found")
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+// partFitsCategory reports whether a part may be used on assets of the
+// category. Parts without categories fit every asset.
+func partFitsCategory(part *models.Part, categoryID string) bool {
+	if len(part.Categories) == 0 {
+		return true
+	}
+	for _, category := range part.Categories {
+		if category.ID == categoryID {
+			return true
+		}
+	}
+	return false
+}
+
+// deductRecordParts takes the parts used on a record out of stock. It fails
+// when any location does not hold enough, so the whole status change rolls
+// back.
+func deductRecordParts(repo repositories.PartRepository, recordID string, now time.Time) error {
+	recordParts, err := repo.GetRecordParts(recordID)
+	if err != nil {
+		return err
+	}
+
+	for _, recordPart := range recordParts {
+		if recordPart.DeductedAt != nil {
+			continue
+		}
+		ok, err := repo.DeductStock(recordPart.PartID, recordPart.LocationID, recordPart.Quantity)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("insufficient stock")
+		}
+		if err := repo.SetRecordPartDeductedAt(recordPart.ID, &now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreRecordParts puts the parts taken out of stock for a record back.
+func restoreRecordParts(repo repositories.PartRepository, recordID string) error {
+	recordParts, err := repo.GetRecordParts(recordID)
+	if err != nil {
+		return err
+	}
+
+	for _, recordPart := range recordParts {
+		if recordPart.DeductedAt == nil {
+			continue
+		}
+		if err := repo.RestoreStock(recordPart.PartID, recordPart.LocationID, recordPart.Quantity); err != nil {
+			return err
+		}
+		if err := repo.SetRecordPartDeductedAt(recordPart.ID, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+// maxMentionsPerComment bounds how many users a single comment can notify.
+const maxMentionsPerComment = 20
+
+// mentionPattern matches "@" followed by an email address, e.g.
+// "@jane.doe@example.com".
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w.@+-])@([\w.%+-]+@[\w-]+(?:\.[\w-]+)*\.[A-Za-z]{2,})`)
+
+type RecordCommentService interface {
+	GetRecordComments(recordID, actorID, actorRole string) ([]models.RecordComment, error)
+	CreateRecordComment(comment *models.RecordComment, actorRole string) error
+	UpdateRecordComment(comment *models.RecordComment, actorID, actorRole string) error
+}
+
+type recordCommentService struct {
+	repo             repositories.RecordCommentRepository
+	recordRepo       repositories.MaintenanceRecordRepository
+	userRepo         repositories.UserRepository
+	notificationRepo repositories.NotificationRepository
+	txManager        repositories.TxManager
+	editWindow       time.Duration
+}
+
+func NewRecordCommentService(
+	repo repositories.RecordCommentRepository,
+	recordRepo repositories.MaintenanceRecordRepository,
+	userRepo repositories.UserRepository,
+	notificationRepo repositories.NotificationRepository,
+	txManager repositories.TxManager,
+	editWindow time.Duration,
+) RecordCommentService {
+	return &recordCommentService{
+		repo:             repo,
+		recordRepo:       recordRepo,
+		userRepo:         userRepo,
+		notificationRepo: notificationRepo,
+		txManager:        txManager,
+		editWindow:       editWindow,
+	}
+}
+
+func (s *recordCommentService) GetRecordComments(recordID, actorID, actorRole string) ([]models.RecordComment, error) {
+	if _, err := s.findRecord(recordID, actorID, actorRole); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRecordComments(recordID)
+}
+
+// CreateRecordComment appends a comment to a record's thread and notifies the
+// users it mentions. Technicians may comment on records assigned to them even
+// though they cannot edit the record itself.
+func (s *recordCommentService) CreateRecordComment(comment *models.RecordComment, actorRole string) error {
+	record, err := s.findRecord(comment.RecordID, comment.AuthorID, actorRole)
+	if err != nil {
+		return err
+	}
+
+	mentioned, err := s.findMentionedUsers(comment.Body, comment.AuthorID)
+	if err != nil {
+		return err
+	}
+
+	comment.ID = utils.GenerateUUID()
+	err = s.txManager.Transaction(func(tx *gorm.DB) error {
+		repo := s.repo.WithTx(tx)
+		if err := repo.CreateRecordComment(comment); err != nil {
+			return err
+		}
+		if err := repo.AddMentions(comment.ID, mentionedIDs(mentioned)); err != nil {
+			return err
+		}
+		return s.notifyMentions(tx, record, comment, mentioned)
+	})
+	if err != nil {
+		return err
+	}
+
+	created, err := s.repo.GetRecordCommentByID(comment.ID)
+	if err != nil {
+		return err
+	}
+	*comment = *created
+	return nil
+}
+
+// UpdateRecordComment changes a comment's text. Only its author may, and only
+// within the edit window after posting. Users newly mentioned by the edit are
+// notified.
+func (s *recordCommentService) UpdateRecordComment(comment *models.RecordComment, actorID, actorRole string) error {
+	record, err := s.findRecord(comment.RecordID, actorID, actorRole)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetRecordCommentByID(comment.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("comment not found")
+		}
+		return err
+	}
+	if existing.RecordID != comment.RecordID {
+		return errors.New("comment not found")
+	}
+	if existing.AuthorID != actorID {
+		return errors.New("only the author can edit a comment")
+	}
+	now := time.Now()
+	if now.Sub(existing.CreatedAt) > s.editWindow {
+		return errors.New("comment can no longer be edited")
+	}
+
+	mentioned, err := s.findMentionedUsers(comment.Body, actorID)
+	if err != nil {
+		return err
+	}
+	alreadyMentioned := map[string]bool{}
+	for _, mention := range existing.Mentions {
+		alreadyMentioned[mention.UserID] = true
+	}
+	var newlyMentioned []models.User
+	for _, user := range mentioned {
+		if !alreadyMentioned[user.ID] {
+			newlyMentioned = append(newlyMentioned, user)
+		}
+	}
+
+	existing.Body = comment.Body
+	existing.EditedAt = &now
+	err = s.txManager.Transaction(func(tx *gorm.DB) error {
+		repo := s.repo.WithTx(tx)
+		if err := repo.UpdateRecordCommentBody(existing); err != nil {
+			return err
+		}
+		if err := repo.AddMentions(existing.ID, mentionedIDs(newlyMentioned)); err != nil {
+			return err
+		}
+		return s.notifyMentions(tx, record, existing, newlyMentioned)
+	})
+	if err != nil {
+		return err
+	}
+
+	updated, err := s.repo.GetRecordCommentByID(existing.ID)
+	if err != nil {
+		return err
+	}
+	*comment = *updated
+	return nil
+}
+
+// findRecord loads a record the actor may discuss: technicians only see the
+// threads of records assigned to them or of unassigned records.
+func (s *recordCommentService) findRecord(recordID, actorID, actorRole string) (*models.MaintenanceRecord, error) {
+	record, err := s.recordRepo.GetMaintenanceRecordByID(recordID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("maintenance record not found")
+		}
+		return nil, err
+	}
+	if err := checkRecordOwnership(record, actorID, actorRole); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// findMentionedUsers resolves the @email mentions in a comment body to users,
+// skipping unknown addresses and the author.
+func (s *recordCommentService) findMentionedUsers(body, authorID string) ([]models.User, error) {
+	emails := extractMentions(body)
+	if len(emails) > maxMentionsPerComment {
+		return nil, errors.New("too many mentions in comment")
+	}
+
+	users, err := s.userRepo.GetUsersByEmails(emails)
+	if err != nil {
+		return nil, err
+	}
+
+	mentioned := make([]models.User, 0, len(users))
+	for _, user := range users {
+		if user.ID != authorID {
+			mentioned = append(mentioned, user)
+		}
+	}
+	return mentioned, nil
+}
+
+func (s *recordCommentService) notifyMentions(tx *gorm.DB, record *models.MaintenanceRecord, comment *models.RecordComment, users []models.User) error {
+	notifications := make([]models.Notification, len(users))
+	for i, user := range users {
+		notifications[i] = models.Notification{
+			ID:           utils.GenerateUUID(),
+			UserID:       user.ID,
+			Type:         consts.NotificationTypeMention,
+			Message:      truncate(fmt.Sprintf("You were mentioned on the maintenance record for %s", record.Asset.Name), 500),
+			ResourceType: consts.NotificationResourceRecord,
+			ResourceID:   record.ID,
+			ActorID:      &comment.AuthorID,
+		}
+	}
+	return s.notificationRepo.WithTx(tx).CreateNotifications(notifications)
+}
+
+// extractMentions returns the distinct email addresses mentioned in text,
+// lowercased, in order of appearance.
+func extractMentions(text string) []string {
+	seen := map[string]bool{}
+	var emails []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		email := strings.ToLower(strings.TrimRight(match[1], "."))
+		if !seen[email] {
+			seen[email] = true
+			emails = append(emails, email)
+		}
+	}
+	return emails
+}
+
+func mentionedIDs(users []models.User) []string {
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+	return ids
+}
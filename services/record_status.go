@@ -0,0 +1,24 @@
+package services
+
+// RecordStatusMachine decides which maintenance record status changes are
+// allowed.
+type RecordStatusMachine struct {
+	transitions map[string]map[string]bool
+}
+
+func NewRecordStatusMachine(transitions map[string][]string) *RecordStatusMachine {
+	machine := &RecordStatusMachine{transitions: map[string]map[string]bool{}}
+	for from, targets := range transitions {
+		machine.transitions[from] = map[string]bool{}
+		for _, to := range targets {
+			machine.transitions[from][to] = true
+		}
+	}
+	return machine
+}
+
+// CanTransition reports whether a record may move from one status to another.
+// Keeping the current status is always allowed.
+func (m *RecordStatusMachine) CanTransition(from, to string) bool {
+	return from == to || m.transitions[from][to]
+}
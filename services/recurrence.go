@@ -0,0 +1,227 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/utils"
+)
+
+// scheduleRule returns the parsed recurrence rule of a schedule, or nil when
+// the schedule repeats by IntervalDays or not at all.
+func scheduleRule(schedule *models.MaintenanceSchedule) (*utils.RRule, time.Time, error) {
+	if schedule.RecurrenceRule == nil || *schedule.RecurrenceRule == "" {
+		return nil, time.Time{}, nil
+	}
+
+	rule, err := utils.ParseRRule(*schedule.RecurrenceRule)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	dtstart := schedule.NextMaintenanceDate
+	if schedule.RecurrenceStart != nil {
+		dtstart = *schedule.RecurrenceStart
+	}
+	return rule, dtstart, nil
+}
+
+// applyRecurrenceRule moves a schedule's NextMaintenanceDate onto the first
+// occurrence of its rule at or after it. A new or changed rule is anchored at
+// NextMaintenanceDate; an unchanged one (the same text as previousRule) keeps
+// its RecurrenceStart so resubmitting a schedule does not shift the series.
+func applyRecurrenceRule(schedule *models.MaintenanceSchedule, previousRule *string) error {
+	if schedule.RecurrenceRule == nil || *schedule.RecurrenceRule == "" {
+		schedule.RecurrenceRule = nil
+		schedule.RecurrenceStart = nil
+		return nil
+	}
+
+	if schedule.ScheduleType != consts.ScheduleTypePeriodic {
+		return errors.New("recurrence rules require a periodic schedule")
+	}
+	if schedule.IntervalDays != nil {
+		return errors.New("interval days and recurrence rule cannot both be set")
+	}
+
+	if schedule.RecurrenceStart == nil || previousRule == nil || *previousRule != *schedule.RecurrenceRule {
+		start := schedule.NextMaintenanceDate
+		schedule.RecurrenceStart = &start
+	}
+
+	rule, dtstart, err := scheduleRule(schedule)
+	if err != nil {
+		return errors.New("invalid recurrence rule")
+	}
+
+	occurrences := rule.Next(dtstart, schedule.NextMaintenanceDate, 1)
+	if len(occurrences) == 0 {
+		return errors.New("recurrence rule has no upcoming occurrences")
+	}
+	schedule.NextMaintenanceDate = occurrences[0]
+	return nil
+}
+
+// nextMaintenanceDate returns the date a periodic schedule is due again after
+// the occurrence due on dueDate was completed at completedAt. It returns false
+// when the schedule does not repeat on its own.
+func nextMaintenanceDate(schedule *models.MaintenanceSchedule, dueDate, completedAt time.Time) (time.Time, bool) {
+	if schedule.ScheduleType != consts.ScheduleTypePeriodic {
+		return time.Time{}, false
+	}
+
+	rule, dtstart, err := scheduleRule(schedule)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if rule != nil {
+		// Rule occurrences sit on fixed calendar dates, so the next one is the
+		// first after both the due date and the completion.
+		after := dueDate
+		if completedAt.After(after) {
+			after = completedAt
+		}
+		return rule.After(dtstart, after)
+	}
+
+	if schedule.IntervalDays == nil || *schedule.IntervalDays < 1 {
+		return time.Time{}, false
+	}
+	interval := *schedule.IntervalDays
+
+	if schedule.AdvanceFrom == consts.ScheduleAdvanceFromCompletion {
+		return completedAt.AddDate(0, 0, interval), true
+	}
+
+	// Counting from the due date keeps the schedule on its original cadence;
+	// occurrences that were already missed by a late completion are skipped.
+	next := dueDate.AddDate(0, 0, interval)
+	for !next.After(completedAt) {
+		next = next.AddDate(0, 0, interval)
+	}
+	return next, true
+}
+
+// resumedMaintenanceDate returns the next maintenance date of a schedule
+// resumed at resumeAt under policy. skip_missed keeps the schedule's cadence
+// and drops the occurrences that fell inside the suspension, restart_from_resume
+// starts a fresh cycle at resumeAt, and keep leaves the date as it is so the
+// missed occurrence comes due once.
+func resumedMaintenanceDate(schedule *models.MaintenanceSchedule, resumeAt time.Time, policy string) (time.Time, error) {
+	next := schedule.NextMaintenanceDate
+	if policy == consts.ResumePolicyKeep {
+		return next, nil
+	}
+	if policy == consts.ResumePolicySkipMissed && !next.Before(resumeAt) {
+		return next, nil
+	}
+
+	rule, dtstart, err := scheduleRule(schedule)
+	if err != nil {
+		return next, err
+	}
+
+	interval := 0
+	if schedule.ScheduleType == consts.ScheduleTypePeriodic && schedule.IntervalDays != nil {
+		interval = *schedule.IntervalDays
+	}
+
+	switch {
+	case rule != nil && policy == consts.ResumePolicyRestartFromResume:
+		start := resumeAt
+		schedule.RecurrenceStart = &start
+		if occurrences := rule.Next(resumeAt, resumeAt, 1); len(occurrences) > 0 {
+			return occurrences[0], nil
+		}
+		return resumeAt, nil
+	case rule != nil:
+		if occurrences := rule.Next(dtstart, resumeAt, 1); len(occurrences) > 0 {
+			return occurrences[0], nil
+		}
+		return resumeAt, nil
+	case interval > 0 && policy == consts.ResumePolicyRestartFromResume:
+		return resumeAt.AddDate(0, 0, interval), nil
+	case interval > 0:
+		for next.Before(resumeAt) {
+			next = next.AddDate(0, 0, interval)
+		}
+		return next, nil
+	default:
+		// A one-off date that passed during the suspension comes due on resume.
+		return resumeAt, nil
+	}
+}
+
+// upcomingOccurrences returns up to n due dates of a schedule starting with its
+// NextMaintenanceDate.
+func upcomingOccurrences(schedule *models.MaintenanceSchedule, n int) ([]time.Time, error) {
+	if n < 1 {
+		return nil, nil
+	}
+
+	rule, dtstart, err := scheduleRule(schedule)
+	if err != nil {
+		return nil, err
+	}
+	if rule != nil {
+		return rule.Next(dtstart, schedule.NextMaintenanceDate, n), nil
+	}
+
+	occurrences := []time.Time{schedule.NextMaintenanceDate}
+	if schedule.ScheduleType != consts.ScheduleTypePeriodic || schedule.IntervalDays == nil || *schedule.IntervalDays < 1 {
+		return occurrences, nil
+	}
+	for len(occurrences) < n {
+		last := occurrences[len(occurrences)-1]
+		occurrences = append(occurrences, last.AddDate(0, 0, *schedule.IntervalDays))
+	}
+	return occurrences, nil
+}
+
+// maxCalendarOccurrences bounds the expansion of a single schedule so a daily
+// rule over a long window cannot blow up a calendar response.
+const maxCalendarOccurrences = 1000
+
+// occurrencesBetween returns the due dates of a schedule within [from, to],
+// starting from its NextMaintenanceDate. Earlier occurrences are represented
+// by the records that were generated for them.
+func occurrencesBetween(schedule *models.MaintenanceSchedule, from, to time.Time) ([]time.Time, error) {
+	var occurrences []time.Time
+	if schedule.NextMaintenanceDate.After(to) {
+		return occurrences, nil
+	}
+	if from.Before(schedule.NextMaintenanceDate) {
+		from = schedule.NextMaintenanceDate
+	}
+
+	rule, dtstart, err := scheduleRule(schedule)
+	if err != nil {
+		return nil, err
+	}
+	if rule != nil {
+		rule.IterateFrom(dtstart, from, func(occurrence time.Time) bool {
+			if occurrence.After(to) {
+				return false
+			}
+			occurrences = append(occurrences, occurrence)
+			return len(occurrences) < maxCalendarOccurrences
+		})
+		return occurrences, nil
+	}
+
+	if schedule.ScheduleType != consts.ScheduleTypePeriodic || schedule.IntervalDays == nil || *schedule.IntervalDays < 1 {
+		if !schedule.NextMaintenanceDate.Before(from) {
+			occurrences = append(occurrences, schedule.NextMaintenanceDate)
+		}
+		return occurrences, nil
+	}
+
+	for occurrence := schedule.NextMaintenanceDate; !occurrence.After(to) && len(occurrences) < maxCalendarOccurrences; occurrence = occurrence.AddDate(0, 0, *schedule.IntervalDays) {
+		if !occurrence.Before(from) {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+	return occurrences, nil
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"jaga/consts"
+	"jaga/repositories"
+
+	"gorm.io/gorm"
+)
+
+// maxReliabilityReportWindow bounds the date range of a reliability report.
+const maxReliabilityReportWindow = 5 * 366 * 24 * time.Hour
+
+// ReliabilityMetrics describes how reliable an asset or an asset category was
+// over a report range. MTBF is the operating time, the time in service less
+// repairs, divided by the number of failures; MTTR is the mean time from
+// starting a repair to finishing it. Both are nil without failures or
+// finished repairs.
+type ReliabilityMetrics struct {
+	ID               string
+	Name             string
+	AssetCount       int
+	FailureCount     int
+	RepairCount      int
+	OperatingSeconds int64
+	RepairSeconds    int64
+	MTBFSeconds      *int64
+	MTTRSeconds      *int64
+}
+
+type ReliabilityService interface {
+	GetReliability(groupBy string, from, to time.Time, categoryID string, now time.Time) ([]ReliabilityMetrics, error)
+}
+
+type reliabilityService struct {
+	repo         repositories.ReliabilityRepository
+	categoryRepo repositories.AssetCategoryRepository
+}
+
+func NewReliabilityService(repo repositories.ReliabilityRepository, categoryRepo repositories.AssetCategoryRepository) ReliabilityService {
+	return &reliabilityService{repo: repo, categoryRepo: categoryRepo}
+}
+
+// GetReliability computes MTBF and MTTR per asset or per asset category from
+// the corrective records dated in [from, to). Time after now does not count
+// as operating time.
+func (s *reliabilityService) GetReliability(groupBy string, from, to time.Time, categoryID string, now time.Time) ([]ReliabilityMetrics, error) {
+	if !to.After(from) {
+		return nil, errors.New("report start must be before its end")
+	}
+	if to.Sub(from) > maxReliabilityReportWindow {
+		return nil, errors.New("report range is too long")
+	}
+	if categoryID != "" {
+		if _, err := s.categoryRepo.GetAssetCategoryByID(categoryID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("asset category not found")
+			}
+			return nil, err
+		}
+	}
+
+	end := to
+	if now.Before(end) {
+		end = now
+	}
+
+	assets, err := s.repo.GetAssetsInService(end, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.repo.GetFailureEvents(from, to, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics []*ReliabilityMetrics
+	byID := map[string]*ReliabilityMetrics{}
+	get := func(id, name string) *ReliabilityMetrics {
+		if byID[id] == nil {
+			byID[id] = &ReliabilityMetrics{ID: id, Name: name}
+			metrics = append(metrics, byID[id])
+		}
+		return byID[id]
+	}
+	groupOf := func(assetID, categoryID string) string {
+		if groupBy == consts.ReliabilityGroupByCategory {
+			return categoryID
+		}
+		return assetID
+	}
+
+	if groupBy == consts.ReliabilityGroupByCategory {
+		categories, err := s.categoryRepo.GetAssetCategories()
+		if err != nil {
+			return nil, err
+		}
+		for _, category := range categories {
+			if categoryID == "" || category.ID == categoryID {
+				get(category.ID, category.Name)
+			}
+		}
+	}
+
+	for _, asset := range assets {
+		get(groupOf(asset.ID, asset.CategoryID), asset.Name).AssetCount++
+	}
+
+	downtime := map[string]time.Duration{}
+	for _, event := range events {
+		group := get(groupOf(event.AssetID, event.CategoryID), event.AssetName)
+		group.FailureCount++
+
+		repairEnd := end
+		if event.FinishedAt != nil {
+			repairEnd = *event.FinishedAt
+			if repairEnd.After(event.StartedAt) {
+				group.RepairCount++
+				group.RepairSeconds += int64(repairEnd.Sub(event.StartedAt).Seconds())
+			}
+		}
+		downtime[event.AssetID] += overlap(event.StartedAt, repairEnd, from, end)
+	}
+
+	for _, asset := range assets {
+		group := byID[groupOf(asset.ID, asset.CategoryID)]
+		start := from
+		if asset.InServiceSince.After(start) {
+			start = asset.InServiceSince
+		}
+		operating := end.Sub(start) - downtime[asset.ID]
+		if operating > 0 {
+			group.OperatingSeconds += int64(operating.Seconds())
+		}
+	}
+
+	result := make([]ReliabilityMetrics, len(metrics))
+	for i, group := range metrics {
+		if group.FailureCount > 0 {
+			mtbf := group.OperatingSeconds / int64(group.FailureCount)
+			group.MTBFSeconds = &mtbf
+		}
+		if group.RepairCount > 0 {
+			mttr := group.RepairSeconds / int64(group.RepairCount)
+			group.MTTRSeconds = &mttr
+		}
+		result[i] = *group
+	}
+	return result, nil
+}
+
+// overlap returns how long [start, end) and [windowStart, windowEnd) overlap.
+func overlap(start, end, windowStart, windowEnd time.Time) time.Duration {
+	if start.Before(windowStart) {
+		start = windowStart
+	}
+	if end.After(windowEnd) {
+		end = windowEnd
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
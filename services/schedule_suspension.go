@@ -0,0 +1,117 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+)
+
+func (s *maintenanceScheduleService) SuspendMaintenanceSchedule(scheduleID, suspendedBy, reason string, until *time.Time, resumePolicy string, now time.Time) error {
+	schedule, err := s.GetMaintenanceScheduleByID(scheduleID)
+	if err != nil {
+		return err
+	}
+	if schedule.SuspendedAt != nil {
+		return errors.New("maintenance schedule is already suspended")
+	}
+	if until != nil && !until.After(now) {
+		return errors.New("suspended until must be in the future")
+	}
+
+	if resumePolicy == "" {
+		resumePolicy = consts.ResumePolicySkipMissed
+	}
+
+	schedule.SuspendedAt = &now
+	schedule.SuspendedUntil = until
+	schedule.SuspendedBy = &suspendedBy
+	schedule.SuspendReason = reason
+	schedule.ResumePolicy = resumePolicy
+	return s.repo.UpdateMaintenanceSchedule(schedule)
+}
+
+// ResumeMaintenanceSchedule lifts the suspension of a schedule and moves its
+// next maintenance date according to policy, falling back to the policy chosen
+// when the schedule was suspended.
+func (s *maintenanceScheduleService) ResumeMaintenanceSchedule(scheduleID, policy string, now time.Time) error {
+	schedule, err := s.GetMaintenanceScheduleByID(scheduleID)
+	if err != nil {
+		return err
+	}
+	if schedule.SuspendedAt == nil {
+		return errors.New("maintenance schedule is not suspended")
+	}
+	return resumeSchedule(s.repo, schedule, policy, now)
+}
+
+func resumeSchedule(repo repositories.MaintenanceScheduleRepository, schedule *models.MaintenanceSchedule, policy string, resumeAt time.Time) error {
+	if policy == "" {
+		policy = schedule.ResumePolicy
+	}
+	if policy == "" {
+		policy = consts.ResumePolicySkipMissed
+	}
+
+	next, err := resumedMaintenanceDate(schedule, resumeAt, policy)
+	if err != nil {
+		return err
+	}
+
+	schedule.NextMaintenanceDate = next
+	schedule.SuspendedAt = nil
+	schedule.SuspendedUntil = nil
+	schedule.SuspendedBy = nil
+	schedule.SuspendReason = ""
+	schedule.ResumePolicy = ""
+	return repo.UpdateMaintenanceSchedule(schedule)
+}
+
+// isSuspendedOn reports whether an occurrence on date falls inside the
+// schedule's suspension.
+func isSuspendedOn(schedule *models.MaintenanceSchedule, date time.Time) bool {
+	if schedule.SuspendedAt == nil {
+		return false
+	}
+	return schedule.SuspendedUntil == nil || date.Before(*schedule.SuspendedUntil)
+}
+
+// ResumeSchedulesJob resumes suspended schedules once their suspended-until
+// date is reached. It should run before DueScheduleJob so that resumed
+// schedules are picked up in the same tick.
+type ResumeSchedulesJob struct {
+	scheduleRepo repositories.MaintenanceScheduleRepository
+}
+
+func NewResumeSchedulesJob(scheduleRepo repositories.MaintenanceScheduleRepository) *ResumeSchedulesJob {
+	return &ResumeSchedulesJob{scheduleRepo: scheduleRepo}
+}
+
+func (j *ResumeSchedulesJob) Name() string {
+	return "resume-schedules"
+}
+
+func (j *ResumeSchedulesJob) Run(now time.Time) error {
+	schedules, err := j.scheduleRepo.GetMaintenanceSchedulesToResume(now)
+	if err != nil {
+		return err
+	}
+
+	resumed := 0
+	for i := range schedules {
+		schedule := &schedules[i]
+		if err := resumeSchedule(j.scheduleRepo, schedule, "", *schedule.SuspendedUntil); err != nil {
+			log.Printf("Failed to resume maintenance schedule %s: %v", schedule.ID, err)
+			continue
+		}
+		resumed++
+	}
+
+	if resumed > 0 {
+		log.Printf("Resumed %d suspended maintenance schedule(s)", resumed)
+	}
+	return nil
+}
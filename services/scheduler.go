@@ -1,3 +1,228 @@
 package services
 
-//every 24 hours, for dueSchedulesWithoutRecords[] { CreateMaintenanceRecord() }
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+
+	"gorm.io/gorm"
+)
+
+// Clock abstracts the current time so background jobs can be driven by a
+// fake clock in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock returns a Clock backed by time.Now.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// Job is a unit of background work run on every scheduler tick.
+type Job interface {
+	Name() string
+	Run(now time.Time) error
+}
+
+// Scheduler runs its jobs once on start and then on every tick until stopped.
+type Scheduler struct {
+	interval time.Duration
+	clock    Clock
+	jobs     []Job
+	leader   Leadership
+
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func NewScheduler(interval time.Duration, clock Clock, jobs ...Job) *Scheduler {
+	if clock == nil {
+		clock = SystemClock()
+	}
+	return &Scheduler{
+		interval: interval,
+		clock:    clock,
+		jobs:     jobs,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// RequireLeadership makes the scheduler skip ticks while this instance is not
+// the cluster leader.
+func (s *Scheduler) RequireLeadership(leader Leadership) {
+	s.leader = leader
+}
+
+func (s *Scheduler) Start() {
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.RunOnce()
+		for {
+			select {
+			case <-ticker.C:
+				s.RunOnce()
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop signals the scheduler to exit and waits for the running tick, if any,
+// to finish.
+func (s *Scheduler) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+	<-s.done
+}
+
+// RunOnce runs every job a single time using the scheduler's clock.
+func (s *Scheduler) RunOnce() {
+	if s.leader != nil && !s.leader.IsLeader() {
+		return
+	}
+
+	now := s.clock.Now()
+	for _, job := range s.jobs {
+		if err := job.Run(now); err != nil {
+			log.Printf("Scheduler job %s failed: %v", job.Name(), err)
+		}
+	}
+}
+
+// DueScheduleJob creates a pending maintenance record for every schedule that
+// has come due and has no open record yet. A due date on a closed day is moved
+// according to the schedule's closed-day policy. Records of unassigned
+// schedules go to the technician picked by the assignment service.
+type DueScheduleJob struct {
+	scheduleRepo  repositories.MaintenanceScheduleRepository
+	recordService MaintenanceRecordService
+	assigner      AssignmentService
+	holidayRepo   repositories.HolidayCalendarRepository
+}
+
+func NewDueScheduleJob(
+	scheduleRepo repositories.MaintenanceScheduleRepository,
+	recordService MaintenanceRecordService,
+	assigner AssignmentService,
+	holidayRepo repositories.HolidayCalendarRepository,
+) *DueScheduleJob {
+	return &DueScheduleJob{
+		scheduleRepo:  scheduleRepo,
+		recordService: recordService,
+		assigner:      assigner,
+		holidayRepo:   holidayRepo,
+	}
+}
+
+func (j *DueScheduleJob) Name() string {
+	return "due-schedules"
+}
+
+func (j *DueScheduleJob) Run(now time.Time) error {
+	schedules, err := j.scheduleRepo.GetDueMaintenanceSchedules(now.Add(maxClosedDayShift))
+	if err != nil {
+		return err
+	}
+
+	resolver := newClosedDayResolver(j.holidayRepo)
+	created := 0
+	for _, schedule := range schedules {
+		effective, err := resolver.effectiveDate(&schedule, schedule.NextMaintenanceDate)
+		if err != nil {
+			log.Printf("Failed to resolve closed days for schedule %s: %v", schedule.ID, err)
+			effective = schedule.NextMaintenanceDate
+		}
+		if effective.After(now) {
+			continue
+		}
+
+		record := newScheduledRecord(schedule)
+		record.MaintenanceDate = effective
+		err = createScheduledRecord(j.assigner, &schedule, record, func(record *models.MaintenanceRecord) error {
+			return j.recordService.CreateMaintenanceRecord(record, "")
+		})
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			// The due query leaves out occurrences that already have a
+			// record, so only another instance can have created it since,
+			// e.g. during a leader failover.
+			continue
+		}
+		if err != nil {
+			log.Printf("Failed to create maintenance record for schedule %s: %v", schedule.ID, err)
+			continue
+		}
+		created++
+	}
+
+	if created > 0 {
+		log.Printf("Created %d maintenance record(s) from due schedules", created)
+	}
+	return nil
+}
+
+// createScheduledRecord assigns a record generated from a schedule and saves
+// it with create. A failed assignment is logged and the record is still
+// created, unassigned. A record whose schedule names a user that no longer
+// exists is reassigned once.
+func createScheduledRecord(
+	assigner AssignmentService,
+	schedule *models.MaintenanceSchedule,
+	record *models.MaintenanceRecord,
+	create func(record *models.MaintenanceRecord) error,
+) error {
+	if err := assigner.AssignRecord(record, schedule.Asset.CategoryID); err != nil {
+		log.Printf("Failed to assign maintenance record for schedule %s: %v", schedule.ID, err)
+	}
+	err := create(record)
+	if err != nil && err.Error() == "performed by not found" {
+		log.Printf("Schedule %s is assigned to unknown user %s, reassigning record", schedule.ID, schedule.AssignedTo)
+		record.PerformedBy = nil
+		if err := assigner.AssignRecord(record, schedule.Asset.CategoryID); err != nil {
+			log.Printf("Failed to assign maintenance record for schedule %s: %v", schedule.ID, err)
+		}
+		err = create(record)
+	}
+	return err
+}
+
+func newScheduledRecord(schedule models.MaintenanceSchedule) *models.MaintenanceRecord {
+	scheduleID := schedule.ID
+	dueAt := schedule.NextMaintenanceDate
+
+	var performedBy *string
+	if schedule.AssignedTo != "" {
+		assignedTo := schedule.AssignedTo
+		performedBy = &assignedTo
+	}
+
+	return &models.MaintenanceRecord{
+		AssetID:         schedule.AssetID,
+		ScheduleID:      &scheduleID,
+		PerformedBy:     performedBy,
+		Description:     fmt.Sprintf("Scheduled %s maintenance for %s", schedule.ScheduleType, schedule.Asset.Name),
+		Status:          consts.RecordStatusPending,
+		MaintenanceDate: schedule.NextMaintenanceDate,
+		DueAt:           &dueAt,
+	}
+}
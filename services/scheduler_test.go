@@ -0,0 +1,256 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+
+	"gorm.io/gorm"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+type notLeader struct{}
+
+func (notLeader) IsLeader() bool {
+	return false
+}
+
+// stubScheduleRepository returns every schedule nominally due before the
+// requested time, like the real query.
+type stubScheduleRepository struct {
+	repositories.MaintenanceScheduleRepository
+	schedules []models.MaintenanceSchedule
+	dueBefore []time.Time
+}
+
+func (r *stubScheduleRepository) GetDueMaintenanceSchedules(dueBefore time.Time) ([]models.MaintenanceSchedule, error) {
+	r.dueBefore = append(r.dueBefore, dueBefore)
+	var due []models.MaintenanceSchedule
+	for _, schedule := range r.schedules {
+		if !schedule.NextMaintenanceDate.After(dueBefore) {
+			due = append(due, schedule)
+		}
+	}
+	return due, nil
+}
+
+// stubRecordService keeps the records it is asked to create and fails with a
+// duplicate key for schedules listed in duplicates.
+type stubRecordService struct {
+	MaintenanceRecordService
+	created    []*models.MaintenanceRecord
+	duplicates map[string]bool
+}
+
+func (s *stubRecordService) CreateMaintenanceRecord(record *models.MaintenanceRecord, actorID string) error {
+	if record.ScheduleID != nil && s.duplicates[*record.ScheduleID] {
+		return gorm.ErrDuplicatedKey
+	}
+	s.created = append(s.created, record)
+	return nil
+}
+
+type stubAssigner struct {
+	AssignmentService
+	technicianID string
+}
+
+func (a *stubAssigner) AssignRecord(record *models.MaintenanceRecord, categoryID string) error {
+	if record.PerformedBy == nil {
+		technicianID := a.technicianID
+		record.PerformedBy = &technicianID
+	}
+	return nil
+}
+
+type stubHolidayRepository struct {
+	repositories.HolidayCalendarRepository
+	calendar *models.HolidayCalendar
+	holidays []models.Holiday
+}
+
+func (r *stubHolidayRepository) GetHolidayCalendarByID(calendarID string) (*models.HolidayCalendar, error) {
+	if r.calendar == nil || r.calendar.ID != calendarID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.calendar, nil
+}
+
+func (r *stubHolidayRepository) GetHolidays(calendarID string, from, to *time.Time) ([]models.Holiday, error) {
+	return r.holidays, nil
+}
+
+func scheduleDueAt(id string, due time.Time) models.MaintenanceSchedule {
+	return models.MaintenanceSchedule{
+		ID:                  id,
+		AssetID:             "asset-" + id,
+		Asset:               models.Asset{Name: "Pump " + id, CategoryID: "pumps"},
+		ScheduleType:        consts.ScheduleTypePeriodic,
+		NextMaintenanceDate: due,
+		ClosedDayPolicy:     consts.ClosedDayPolicyKeep,
+	}
+}
+
+func createdByScheduleID(records []*models.MaintenanceRecord) map[string]*models.MaintenanceRecord {
+	byID := map[string]*models.MaintenanceRecord{}
+	for _, record := range records {
+		byID[*record.ScheduleID] = record
+	}
+	return byID
+}
+
+func TestDueScheduleJobCreatesRecordsForDueSchedules(t *testing.T) {
+	// A Friday.
+	now := time.Date(2025, time.March, 7, 10, 0, 0, 0, time.UTC)
+
+	assigned := scheduleDueAt("assigned", now.AddDate(0, 0, -1))
+	assigned.AssignedTo = "tech-assigned"
+	scheduleRepo := &stubScheduleRepository{schedules: []models.MaintenanceSchedule{
+		assigned,
+		scheduleDueAt("unassigned", now.Add(-time.Hour)),
+		scheduleDueAt("exactly-now", now),
+		scheduleDueAt("tomorrow", now.AddDate(0, 0, 1)),
+		scheduleDueAt("next-week", now.AddDate(0, 0, 7)),
+	}}
+	recordService := &stubRecordService{}
+	clock := &fakeClock{now: now}
+	scheduler := NewScheduler(time.Hour, clock,
+		NewDueScheduleJob(scheduleRepo, recordService, &stubAssigner{technicianID: "tech-picked"}, &stubHolidayRepository{}))
+
+	scheduler.RunOnce()
+
+	if len(scheduleRepo.dueBefore) != 1 || !scheduleRepo.dueBefore[0].Equal(now.Add(maxClosedDayShift)) {
+		t.Fatalf("schedules queried up to %v, want %v", scheduleRepo.dueBefore, now.Add(maxClosedDayShift))
+	}
+
+	created := createdByScheduleID(recordService.created)
+	if len(created) != 3 {
+		t.Fatalf("created %d records, want 3 for the due schedules: %v", len(created), created)
+	}
+	for _, id := range []string{"tomorrow", "next-week"} {
+		if _, ok := created[id]; ok {
+			t.Errorf("created a record for schedule %s, which is not due yet", id)
+		}
+	}
+
+	record := created["assigned"]
+	if record == nil {
+		t.Fatal("no record for the assigned schedule")
+	}
+	if record.Status != consts.RecordStatusPending {
+		t.Errorf("status = %q, want pending", record.Status)
+	}
+	if record.AssetID != assigned.AssetID {
+		t.Errorf("asset = %q, want %q", record.AssetID, assigned.AssetID)
+	}
+	if record.PerformedBy == nil || *record.PerformedBy != "tech-assigned" {
+		t.Errorf("performed by = %v, want the schedule's assignee", record.PerformedBy)
+	}
+	if record.DueAt == nil || !record.DueAt.Equal(assigned.NextMaintenanceDate) {
+		t.Errorf("due at = %v, want %s", record.DueAt, assigned.NextMaintenanceDate)
+	}
+
+	if record := created["unassigned"]; record == nil || record.PerformedBy == nil || *record.PerformedBy != "tech-picked" {
+		t.Errorf("unassigned schedule's record = %+v, want it assigned to the picked technician", record)
+	}
+
+	// Once the clock reaches the next schedule, it comes due as well.
+	recordService.created = nil
+	clock.now = now.AddDate(0, 0, 1)
+	scheduler.RunOnce()
+	if _, ok := createdByScheduleID(recordService.created)["tomorrow"]; !ok {
+		t.Error("no record for schedule tomorrow once the clock reached its due date")
+	}
+	if _, ok := createdByScheduleID(recordService.created)["next-week"]; ok {
+		t.Error("created a record for schedule next-week before it was due")
+	}
+}
+
+func TestDueScheduleJobMovesDueDatesOffClosedDays(t *testing.T) {
+	// A Friday, and a holiday like the following Monday.
+	now := time.Date(2025, time.March, 7, 10, 0, 0, 0, time.UTC)
+	calendarID := "calendar"
+	holidayRepo := &stubHolidayRepository{
+		calendar: &models.HolidayCalendar{ID: calendarID, WorkingDays: "mon,tue,wed,thu,fri"},
+		holidays: []models.Holiday{
+			{CalendarID: calendarID, Date: time.Date(2025, time.March, 7, 0, 0, 0, 0, time.UTC)},
+			{CalendarID: calendarID, Date: time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	// Due this morning, but moved forward to Tuesday.
+	forward := scheduleDueAt("forward", now.Add(-time.Hour))
+	forward.HolidayCalendarID = &calendarID
+	forward.ClosedDayPolicy = consts.ClosedDayPolicyShiftForward
+	// Due on Monday, but moved back to Thursday.
+	backward := scheduleDueAt("backward", time.Date(2025, time.March, 10, 9, 0, 0, 0, time.UTC))
+	backward.HolidayCalendarID = &calendarID
+	backward.ClosedDayPolicy = consts.ClosedDayPolicyShiftBackward
+
+	scheduleRepo := &stubScheduleRepository{schedules: []models.MaintenanceSchedule{forward, backward}}
+	recordService := &stubRecordService{}
+	scheduler := NewScheduler(time.Hour, &fakeClock{now: now},
+		NewDueScheduleJob(scheduleRepo, recordService, &stubAssigner{technicianID: "tech"}, holidayRepo))
+
+	scheduler.RunOnce()
+
+	created := createdByScheduleID(recordService.created)
+	if _, ok := created["forward"]; ok {
+		t.Error("created a record for a schedule moved forward past now")
+	}
+	record := created["backward"]
+	if record == nil {
+		t.Fatal("no record for the schedule moved back before now")
+	}
+	if want := time.Date(2025, time.March, 6, 9, 0, 0, 0, time.UTC); !record.MaintenanceDate.Equal(want) {
+		t.Errorf("maintenance date = %s, want %s", record.MaintenanceDate, want)
+	}
+	if record.DueAt == nil || !record.DueAt.Equal(backward.NextMaintenanceDate) {
+		t.Errorf("due at = %v, want the nominal date %s", record.DueAt, backward.NextMaintenanceDate)
+	}
+}
+
+func TestDueScheduleJobSkipsOccurrencesThatAlreadyHaveARecord(t *testing.T) {
+	now := time.Date(2025, time.March, 7, 10, 0, 0, 0, time.UTC)
+	scheduleRepo := &stubScheduleRepository{schedules: []models.MaintenanceSchedule{
+		scheduleDueAt("existing", now.Add(-time.Hour)),
+		scheduleDueAt("new", now.Add(-time.Hour)),
+	}}
+	recordService := &stubRecordService{duplicates: map[string]bool{"existing": true}}
+	job := NewDueScheduleJob(scheduleRepo, recordService, &stubAssigner{technicianID: "tech"}, &stubHolidayRepository{})
+
+	if err := job.Run(now); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	created := createdByScheduleID(recordService.created)
+	if len(created) != 1 || created["new"] == nil {
+		t.Errorf("created records for %v, want only schedule new", created)
+	}
+}
+
+func TestSchedulerSkipsTicksWithoutLeadership(t *testing.T) {
+	now := time.Date(2025, time.March, 7, 10, 0, 0, 0, time.UTC)
+	scheduleRepo := &stubScheduleRepository{schedules: []models.MaintenanceSchedule{
+		scheduleDueAt("due", now.Add(-time.Hour)),
+	}}
+	recordService := &stubRecordService{}
+	scheduler := NewScheduler(time.Hour, &fakeClock{now: now},
+		NewDueScheduleJob(scheduleRepo, recordService, &stubAssigner{technicianID: "tech"}, &stubHolidayRepository{}))
+	scheduler.RequireLeadership(notLeader{})
+
+	scheduler.RunOnce()
+
+	if len(scheduleRepo.dueBefore) != 0 || len(recordService.created) != 0 {
+		t.Errorf("a follower ran the job: queried %v, created %d record(s)", scheduleRepo.dueBefore, len(recordService.created))
+	}
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+type SLAPolicyService interface {
+	GetSLAPolicies() ([]models.SLAPolicy, error)
+	SetSLAPolicy(policy *models.SLAPolicy) error
+	DeleteSLAPolicy(priority string) error
+}
+
+type slaPolicyService struct {
+	repo repositories.SLARepository
+}
+
+func NewSLAPolicyService(repo repositories.SLARepository) SLAPolicyService {
+	return &slaPolicyService{repo: repo}
+}
+
+func (s *slaPolicyService) GetSLAPolicies() ([]models.SLAPolicy, error) {
+	return s.repo.GetSLAPolicies()
+}
+
+// SetSLAPolicy creates or replaces the policy of a priority. Records keep the
+// deadlines they were given until their priority or maintenance date changes.
+func (s *slaPolicyService) SetSLAPolicy(policy *models.SLAPolicy) error {
+	if !slices.Contains(consts.AllPriorities, policy.Priority) {
+		return errors.New("invalid priority")
+	}
+	if policy.ResolutionMinutes < policy.ResponseMinutes {
+		return errors.New("resolution time cannot be shorter than response time")
+	}
+
+	if existing, err := s.repo.GetSLAPolicy(policy.Priority); err == nil {
+		policy.CreatedAt = existing.CreatedAt
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return s.repo.SaveSLAPolicy(policy)
+}
+
+func (s *slaPolicyService) DeleteSLAPolicy(priority string) error {
+	if _, err := s.repo.GetSLAPolicy(priority); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("sla policy not found")
+		}
+		return err
+	}
+	return s.repo.DeleteSLAPolicy(priority)
+}
+
+// applySLAPolicy sets a record's deadlines from the policy of its priority,
+// counted from when the record becomes due: its creation, or its maintenance
+// date when that is later. Priorities without a policy have no deadlines.
+// New deadlines are escalated afresh.
+func applySLAPolicy(repo repositories.SLARepository, record *models.MaintenanceRecord, createdAt time.Time) error {
+	record.ResponseDueAt = nil
+	record.ResolutionDueAt = nil
+	record.ResponseEscalatedAt = nil
+	record.ResolutionEscalatedAt = nil
+
+	policy, err := repo.GetSLAPolicy(record.Priority)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	start := createdAt
+	if record.MaintenanceDate.After(start) {
+		start = record.MaintenanceDate
+	}
+	responseDue := start.Add(time.Duration(policy.ResponseMinutes) * time.Minute)
+	resolutionDue := start.Add(time.Duration(policy.ResolutionMinutes) * time.Minute)
+	record.ResponseDueAt = &responseDue
+	record.ResolutionDueAt = &resolutionDue
+	return nil
+}
+
+// trackSLA follows a record's status: the first time work starts is its
+// response, and finishing it is its resolution. Either one coming after its
+// deadline flags the record as breached.
+func trackSLA(record *models.MaintenanceRecord, now time.Time) {
+	started := record.Status == consts.RecordStatusInProgress || record.Status == consts.RecordStatusFinished
+	if started && record.RespondedAt == nil {
+		record.RespondedAt = &now
+		if record.ResponseDueAt != nil && now.After(*record.ResponseDueAt) {
+			record.SLABreached = true
+		}
+	}
+	if record.Status == consts.RecordStatusFinished &&
+		record.ResolutionDueAt != nil && now.After(*record.ResolutionDueAt) {
+		record.SLABreached = true
+	}
+}
+
+// SLAEscalationJob flags open records that missed a deadline as breached, and
+// warns the assignee's manager and the admins once about every response or
+// resolution deadline that is within the lead time or already missed.
+type SLAEscalationJob struct {
+	slaRepo          repositories.SLARepository
+	userRepo         repositories.UserRepository
+	notificationRepo repositories.NotificationRepository
+	lead             time.Duration
+}
+
+func NewSLAEscalationJob(
+	slaRepo repositories.SLARepository,
+	userRepo repositories.UserRepository,
+	notificationRepo repositories.NotificationRepository,
+	lead time.Duration,
+) *SLAEscalationJob {
+	return &SLAEscalationJob{
+		slaRepo:          slaRepo,
+		userRepo:         userRepo,
+		notificationRepo: notificationRepo,
+		lead:             lead,
+	}
+}
+
+func (j *SLAEscalationJob) Name() string {
+	return "sla-escalation"
+}
+
+func (j *SLAEscalationJob) Run(now time.Time) error {
+	breached, err := j.slaRepo.MarkSLABreaches(now)
+	if err != nil {
+		return err
+	}
+	if breached > 0 {
+		log.Printf("Flagged %d maintenance record(s) as breaching their SLA", breached)
+	}
+
+	limit := now.Add(j.lead)
+	records, err := j.slaRepo.GetRecordsToEscalate(limit)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	admins, err := j.userRepo.GetUsersByRole(consts.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	managers := map[string]*string{}
+	escalated := 0
+	for _, record := range records {
+		recipients := make([]string, 0, len(admins)+1)
+		if record.PerformedBy != nil && *record.PerformedBy != "" {
+			managerID, ok := managers[*record.PerformedBy]
+			if !ok {
+				if assignee, err := j.userRepo.GetUserByID(*record.PerformedBy); err == nil {
+					managerID = assignee.ManagerID
+				} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+					log.Printf("Failed to look up the assignee of maintenance record %s: %v", record.ID, err)
+				}
+				managers[*record.PerformedBy] = managerID
+			}
+			if managerID != nil {
+				recipients = append(recipients, *managerID)
+			}
+		}
+		for _, admin := range admins {
+			if !slices.Contains(recipients, admin.ID) {
+				recipients = append(recipients, admin.ID)
+			}
+		}
+
+		response := record.RespondedAt == nil && record.ResponseEscalatedAt == nil &&
+			record.ResponseDueAt != nil && record.ResponseDueAt.Before(limit)
+		resolution := record.ResolutionEscalatedAt == nil &&
+			record.ResolutionDueAt != nil && record.ResolutionDueAt.Before(limit)
+
+		var notifications []models.Notification
+		if response {
+			notifications = append(notifications, slaNotifications(&record, recipients, "response", *record.ResponseDueAt, now)...)
+		}
+		if resolution {
+			notifications = append(notifications, slaNotifications(&record, recipients, "resolution", *record.ResolutionDueAt, now)...)
+		}
+		if err := j.notificationRepo.CreateNotifications(notifications); err != nil {
+			log.Printf("Failed to escalate maintenance record %s: %v", record.ID, err)
+			continue
+		}
+		if err := j.slaRepo.MarkSLAEscalated(record.ID, response, resolution, now); err != nil {
+			log.Printf("Failed to mark maintenance record %s as escalated: %v", record.ID, err)
+			continue
+		}
+		escalated++
+	}
+
+	if escalated > 0 {
+		log.Printf("Escalated %d maintenance record(s) nearing or past their SLA", escalated)
+	}
+	return nil
+}
+
+// slaNotifications warns every recipient that a record's deadline is near, or
+// that it was missed.
+func slaNotifications(record *models.MaintenanceRecord, recipients []string, deadline string, dueAt, now time.Time) []models.Notification {
+	notificationType := consts.NotificationTypeSLAAtRisk
+	message := fmt.Sprintf("The %s maintenance record for %s is due for %s by %s",
+		record.Priority, record.Asset.Name, deadline, dueAt.Format("2006-01-02 15:04"))
+	if !dueAt.After(now) {
+		notificationType = consts.NotificationTypeSLABreached
+		message = fmt.Sprintf("The %s maintenance record for %s missed its %s deadline of %s",
+			record.Priority, record.Asset.Name, deadline, dueAt.Format("2006-01-02 15:04"))
+	}
+
+	notifications := make([]models.Notification, len(recipients))
+	for i, userID := range recipients {
+		notifications[i] = models.Notification{
+			ID:           utils.GenerateUUID(),
+			UserID:       userID,
+			Type:         notificationType,
+			Message:      truncate(message, 500),
+			ResourceType: consts.NotificationResourceRecord,
+			ResourceID:   record.ID,
+		}
+	}
+	return notifications
+}
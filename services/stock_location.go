@@ -0,0 +1,93 @@
+package services
+
+import (
+	"errors"
+
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+type StockLocationService interface {
+	CreateStockLocation(location *models.StockLocation) error
+	GetStockLocations() ([]models.StockLocation, error)
+	GetStockLocationByID(locationID string) (*models.StockLocation, error)
+	UpdateStockLocation(location *models.StockLocation) error
+	DeleteStockLocation(locationID string) error
+}
+
+type stockLocationService struct {
+	repo repositories.StockLocationRepository
+}
+
+func NewStockLocationService(repo repositories.StockLocationRepository) StockLocationService {
+	return &stockLocationService{repo: repo}
+}
+
+func (s *stockLocationService) CreateStockLocation(location *models.StockLocation) error {
+	if location.ID == "" {
+		location.ID = utils.GenerateUUID()
+	}
+	if err := s.repo.CreateStockLocation(location); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errors.New("stock location name already exists")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *stockLocationService) GetStockLocations() ([]models.StockLocation, error) {
+	return s.repo.GetStockLocations()
+}
+
+func (s *stockLocationService) GetStockLocationByID(locationID string) (*models.StockLocation, error) {
+	location, err := s.repo.GetStockLocationByID(locationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("stock location not found")
+		}
+		return nil, err
+	}
+	return location, nil
+}
+
+func (s *stockLocationService) UpdateStockLocation(location *models.StockLocation) error {
+	existing, err := s.GetStockLocationByID(location.ID)
+	if err != nil {
+		return err
+	}
+
+	if location.Name != "" {
+		existing.Name = location.Name
+	}
+	existing.Description = location.Description
+
+	if err := s.repo.UpdateStockLocation(existing); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errors.New("stock location name already exists")
+		}
+		return err
+	}
+	*location = *existing
+	return nil
+}
+
+// DeleteStockLocation deletes a location that holds no stock and was never
+// used on a maintenance record.
+func (s *stockLocationService) DeleteStockLocation(locationID string) error {
+	if _, err := s.GetStockLocationByID(locationID); err != nil {
+		return err
+	}
+
+	inUse, err := s.repo.IsStockLocationInUse(locationID)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return errors.New("stock location is in use")
+	}
+	return s.repo.DeleteStockLocation(locationID)
+}
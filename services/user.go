@@ -73,6 +73,9 @@ func (s *userService) CreateUser(user *models.User, requesterRole string) error
 	if user.ID == "" {
 		user.ID = utils.GenerateUUID()
 	}
+	if err := s.checkManager(user); err != nil {
+		return err
+	}
 
 	hashedPassword, err := utils.HashPassword(user.PasswordHash)
 	if err != nil {
@@ -106,10 +109,41 @@ func (s *userService) UpdateUser(user *models.User, requesterRole string) error
 	} else {
 		user.PasswordHash = existingUser.PasswordHash
 	}
+	if user.HourlyRate == nil {
+		user.HourlyRate = existingUser.HourlyRate
+	}
+	if user.ManagerID == nil {
+		user.ManagerID = existingUser.ManagerID
+	}
+	if err := s.checkManager(user); err != nil {
+		return err
+	}
 
 	return s.userRepo.UpdateUser(user)
 }
 
+// checkManager validates the manager a user reports to. An empty manager ID
+// clears it.
+func (s *userService) checkManager(user *models.User) error {
+	if user.ManagerID == nil {
+		return nil
+	}
+	if *user.ManagerID == "" {
+		user.ManagerID = nil
+		return nil
+	}
+	if *user.ManagerID == user.ID {
+		return errors.New("user cannot be their own manager")
+	}
+	if _, err := s.userRepo.GetUserByID(*user.ManagerID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("manager not found")
+		}
+		return err
+	}
+	return nil
+}
+
 func (s *userService) DeleteUser(userID, requesterRole string) error {
 	existingUser, err := s.userRepo.GetUserByID(userID)
 	if err != nil {
@@ -0,0 +1,253 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+	"jaga/utils"
+
+	"gorm.io/gorm"
+)
+
+type WorkRequestService interface {
+	CreateWorkRequest(request *models.WorkRequest) error
+	GetWorkRequests(page, itemsPerPage int, status, urgency, assetID, actorID, actorRole string) ([]models.WorkRequest, int64, error)
+	GetWorkRequestByID(requestID, actorID, actorRole string) (*models.WorkRequest, error)
+	TriageWorkRequest(requestID string, record *models.MaintenanceRecord, feedback, actorID string) (*models.WorkRequest, error)
+	MergeWorkRequest(requestID, targetID, feedback, actorID string) (*models.WorkRequest, error)
+	RejectWorkRequest(requestID, feedback, actorID string) (*models.WorkRequest, error)
+}
+
+type workRequestService struct {
+	repo             repositories.WorkRequestRepository
+	assetRepo        repositories.AssetRepository
+	notificationRepo repositories.NotificationRepository
+	recordService    MaintenanceRecordService
+	txManager        repositories.TxManager
+}
+
+func NewWorkRequestService(
+	repo repositories.WorkRequestRepository,
+	assetRepo repositories.AssetRepository,
+	notificationRepo repositories.NotificationRepository,
+	recordService MaintenanceRecordService,
+	txManager repositories.TxManager,
+) WorkRequestService {
+	return &workRequestService{
+		repo:             repo,
+		assetRepo:        assetRepo,
+		notificationRepo: notificationRepo,
+		recordService:    recordService,
+		txManager:        txManager,
+	}
+}
+
+func (s *workRequestService) CreateWorkRequest(request *models.WorkRequest) error {
+	if _, err := s.assetRepo.GetAssetByID(request.AssetID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("asset not found")
+		}
+		return err
+	}
+
+	request.ID = utils.GenerateUUID()
+	request.Status = consts.WorkRequestStatusOpen
+	if err := s.repo.CreateWorkRequest(request); err != nil {
+		return err
+	}
+
+	created, err := s.repo.GetWorkRequestByID(request.ID)
+	if err != nil {
+		return err
+	}
+	*request = *created
+	return nil
+}
+
+// GetWorkRequests lists work requests, most urgent first. Technicians only
+// see the requests they submitted.
+func (s *workRequestService) GetWorkRequests(page, itemsPerPage int, status, urgency, assetID, actorID, actorRole string) ([]models.WorkRequest, int64, error) {
+	requestedBy := ""
+	if actorRole == consts.RoleTechnician {
+		requestedBy = actorID
+	}
+	return s.repo.GetWorkRequests(page, itemsPerPage, status, urgency, assetID, requestedBy)
+}
+
+func (s *workRequestService) GetWorkRequestByID(requestID, actorID, actorRole string) (*models.WorkRequest, error) {
+	request, err := s.findWorkRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkWorkRequestAccess(request, actorID, actorRole); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// TriageWorkRequest accepts an open request by creating a pending maintenance
+// record for its asset, linked back to the request. The record's description
+// defaults to the request's, its priority to the request's urgency and its
+// date to now. The request stays open if the record cannot be created.
+func (s *workRequestService) TriageWorkRequest(requestID string, record *models.MaintenanceRecord, feedback, actorID string) (*models.WorkRequest, error) {
+	request, err := s.findWorkRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	request.Status = consts.WorkRequestStatusTriaged
+	request.Feedback = feedback
+	request.TriagedBy = &actorID
+	request.TriagedAt = &now
+
+	record.AssetID = request.AssetID
+	record.WorkRequestID = &request.ID
+	record.Status = consts.RecordStatusPending
+	if record.Description == "" {
+		record.Description = request.Description
+	}
+	if record.Priority == "" {
+		record.Priority = request.Urgency
+	}
+	if record.MaintenanceDate.IsZero() {
+		record.MaintenanceDate = now
+	}
+
+	err = s.txManager.Transaction(func(tx *gorm.DB) error {
+		if err := decideWorkRequest(s.repo.WithTx(tx), request); err != nil {
+			return err
+		}
+		return s.recordService.CreateMaintenanceRecordTx(tx, record, actorID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyRequester(request, consts.NotificationTypeWorkRequestTriaged,
+		fmt.Sprintf("Your work request for %s was accepted", request.Asset.Name))
+	return s.findWorkRequest(requestID)
+}
+
+// MergeWorkRequest closes an open request as a duplicate of another request
+// for the same asset.
+func (s *workRequestService) MergeWorkRequest(requestID, targetID, feedback, actorID string) (*models.WorkRequest, error) {
+	request, err := s.findWorkRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+	if targetID == requestID {
+		return nil, errors.New("work request cannot be merged into itself")
+	}
+
+	target, err := s.repo.GetWorkRequestByID(targetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("target work request not found")
+		}
+		return nil, err
+	}
+	if target.AssetID != request.AssetID {
+		return nil, errors.New("work requests are for different assets")
+	}
+	if target.Status == consts.WorkRequestStatusMerged || target.Status == consts.WorkRequestStatusRejected {
+		return nil, errors.New("target work request is closed")
+	}
+
+	now := time.Now()
+	request.Status = consts.WorkRequestStatusMerged
+	request.MergedIntoID = &target.ID
+	request.Feedback = feedback
+	request.TriagedBy = &actorID
+	request.TriagedAt = &now
+	if err := decideWorkRequest(s.repo, request); err != nil {
+		return nil, err
+	}
+
+	s.notifyRequester(request, consts.NotificationTypeWorkRequestMerged,
+		fmt.Sprintf("Your work request for %s was merged with an earlier report of the same problem", request.Asset.Name))
+	return s.findWorkRequest(requestID)
+}
+
+// RejectWorkRequest closes an open request without action and tells the
+// requester why.
+func (s *workRequestService) RejectWorkRequest(requestID, feedback, actorID string) (*models.WorkRequest, error) {
+	request, err := s.findWorkRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	request.Status = consts.WorkRequestStatusRejected
+	request.Feedback = feedback
+	request.TriagedBy = &actorID
+	request.TriagedAt = &now
+	if err := decideWorkRequest(s.repo, request); err != nil {
+		return nil, err
+	}
+
+	s.notifyRequester(request, consts.NotificationTypeWorkRequestRejected,
+		fmt.Sprintf("Your work request for %s was rejected: %s", request.Asset.Name, feedback))
+	return s.findWorkRequest(requestID)
+}
+
+// decideWorkRequest saves a triage decision on a request that is still open.
+func decideWorkRequest(repo repositories.WorkRequestRepository, request *models.WorkRequest) error {
+	updated, err := repo.UpdateWorkRequestStatus(request, consts.WorkRequestStatusOpen)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return errors.New("work request is already triaged")
+	}
+	return nil
+}
+
+// notifyRequester tells the requester about a triage decision. The decision
+// stands even if the notification cannot be saved.
+func (s *workRequestService) notifyRequester(request *models.WorkRequest, notificationType, message string) {
+	if request.TriagedBy != nil && *request.TriagedBy == request.RequestedBy {
+		return
+	}
+	err := s.notificationRepo.CreateNotifications([]models.Notification{{
+		ID:           utils.GenerateUUID(),
+		UserID:       request.RequestedBy,
+		Type:         notificationType,
+		Message:      truncate(message, 500),
+		ResourceType: consts.NotificationResourceWorkRequest,
+		ResourceID:   request.ID,
+		ActorID:      request.TriagedBy,
+	}})
+	if err != nil {
+		log.Printf("Failed to notify requester of work request %s: %v", request.ID, err)
+	}
+}
+
+func (s *workRequestService) findWorkRequest(requestID string) (*models.WorkRequest, error) {
+	request, err := s.repo.GetWorkRequestByID(requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("work request not found")
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+// checkWorkRequestAccess lets technicians see only the requests they
+// submitted and those that became records assigned to them. Other roles see
+// every request.
+func checkWorkRequestAccess(request *models.WorkRequest, actorID, actorRole string) error {
+	if actorRole != consts.RoleTechnician || request.RequestedBy == actorID {
+		return nil
+	}
+	if request.Record != nil && request.Record.PerformedBy != nil && *request.Record.PerformedBy == actorID {
+		return nil
+	}
+	return errors.New("access to work request denied")
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"jaga/consts"
+	"jaga/models"
+	"jaga/repositories"
+
+	"gorm.io/gorm"
+)
+
+// maxClosedDayShift is the furthest a due date is moved to reach an open day.
+// Date-driven queries look this far ahead so that occurrences shifted
+// backwards are still picked up on time.
+const maxClosedDayShift = 31 * 24 * time.Hour
+
+// workingCalendar answers whether a site is open on a given day.
+type workingCalendar struct {
+	workingDays map[time.Weekday]bool
+	holidays    map[string]bool
+}
+
+func newWorkingCalendar(calendar *models.HolidayCalendar, holidays []models.Holiday) *workingCalendar {
+	wc := &workingCalendar{
+		workingDays: parseWorkingDays(calendar.WorkingDays),
+		holidays:    make(map[string]bool, len(holidays)),
+	}
+	for _, holiday := range holidays {
+		wc.holidays[holiday.Date.Format("2006-01-02")] = true
+	}
+	return wc
+}
+
+func (wc *workingCalendar) isOpen(date time.Time) bool {
+	return wc.workingDays[date.Weekday()] && !wc.holidays[date.Format("2006-01-02")]
+}
+
+// shift moves date to the nearest open day in the direction of policy. The
+// date is kept when it is already open, when the policy is keep, or when no
+// open day lies within maxClosedDayShift.
+func (wc *workingCalendar) shift(date time.Time, policy string) time.Time {
+	step := 0
+	switch policy {
+	case consts.ClosedDayPolicyShiftForward:
+		step = 1
+	case consts.ClosedDayPolicyShiftBackward:
+		step = -1
+	}
+	if step == 0 || wc.isOpen(date) {
+		return date
+	}
+
+	maxDays := int(maxClosedDayShift.Hours() / 24)
+	for days := 1; days <= maxDays; days++ {
+		candidate := date.AddDate(0, 0, step*days)
+		if wc.isOpen(candidate) {
+			return candidate
+		}
+	}
+	return date
+}
+
+// parseWorkingDays turns a list such as "mon,tue,wed" into weekdays.
+func parseWorkingDays(value string) map[time.Weekday]bool {
+	days := map[time.Weekday]bool{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for i, weekday := range consts.AllWeekdays {
+			if name == weekday {
+				days[time.Weekday(i)] = true
+			}
+		}
+	}
+	return days
+}
+
+// normalizeWorkingDays validates a comma-separated list of weekday names and
+// returns it in week order, as stored on a HolidayCalendar.
+func normalizeWorkingDays(value string) (string, error) {
+	if strings.TrimSpace(value) == "" {
+		return consts.DefaultWorkingDays, nil
+	}
+
+	names := map[string]bool{}
+	for _, name := range strings.Split(value, ",") {
+		names[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+	days := parseWorkingDays(value)
+	if len(days) != len(names) {
+		return "", errors.New("invalid working days")
+	}
+
+	var ordered []string
+	for i, weekday := range consts.AllWeekdays {
+		if days[time.Weekday(i)] {
+			ordered = append(ordered, weekday)
+		}
+	}
+	return strings.Join(ordered, ","), nil
+}
+
+// closedDayResolver applies the closed-day policy of schedules, loading each
+// holiday calendar at most once. It is meant to live for a single job run or
+// request.
+type closedDayResolver struct {
+	repo      repositories.HolidayCalendarRepository
+	calendars map[string]*workingCalendar
+}
+
+func newClosedDayResolver(repo repositories.HolidayCalendarRepository) *closedDayResolver {
+	return &closedDayResolver{repo: repo, calendars: map[string]*workingCalendar{}}
+}
+
+// effectiveDate returns the day an occurrence nominally due on date is
+// actually planned for. Schedules without a holiday calendar are never moved.
+func (r *closedDayResolver) effectiveDate(schedule *models.MaintenanceSchedule, date time.Time) (time.Time, error) {
+	if schedule.HolidayCalendarID == nil || *schedule.HolidayCalendarID == "" {
+		return date, nil
+	}
+
+	calendarID := *schedule.HolidayCalendarID
+	wc, loaded := r.calendars[calendarID]
+	if !loaded {
+		calendar, err := r.repo.GetHolidayCalendarByID(calendarID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return date, err
+		}
+		if calendar != nil {
+			holidays, err := r.repo.GetHolidays(calendarID, nil, nil)
+			if err != nil {
+				return date, err
+			}
+			wc = newWorkingCalendar(calendar, holidays)
+		}
+		r.calendars[calendarID] = wc
+	}
+
+	if wc == nil {
+		return date, nil
+	}
+	return wc.shift(date, schedule.ClosedDayPolicy), nil
+}
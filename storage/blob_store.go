@@ -0,0 +1,35 @@
+// Package storage keeps uploaded files in a blob store addressed by key.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrBlobNotFound is returned when no blob is stored under a key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// ErrInvalidKey is returned for keys that are empty, absolute or step out of
+// the store with "..".
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// BlobStore stores opaque blobs under slash-separated keys.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
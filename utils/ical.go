@@ -0,0 +1,261 @@
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ICalEvent is a VEVENT written by BuildICalendar. All-day events use only
+// the date of Start.
+type ICalEvent struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	Start        time.Time
+	AllDay       bool
+	Duration     time.Duration
+	Status       string
+	Sequence     int64
+	LastModified time.Time
+}
+
+const (
+	icalDateLayout     = "20060102"
+	icalDateTimeLayout = "20060102T150405Z"
+)
+
+// BuildICalendar renders events as an RFC 5545 VCALENDAR with CRLF line
+// endings and folded long lines.
+func BuildICalendar(name string, events []ICalEvent, stamp time.Time) string {
+	var b strings.Builder
+	write := func(line string) {
+		b.WriteString(foldICalLine(line))
+		b.WriteString("\r\n")
+	}
+
+	write("BEGIN:VCALENDAR")
+	write("VERSION:2.0")
+	write("PRODID:-//Jaga//Maintenance Calendar//EN")
+	write("CALSCALE:GREGORIAN")
+	write("METHOD:PUBLISH")
+	write("X-WR-CALNAME:" + EscapeICalText(name))
+
+	for _, event := range events {
+		write("BEGIN:VEVENT")
+		write("UID:" + event.UID)
+		write("DTSTAMP:" + stamp.UTC().Format(icalDateTimeLayout))
+		if event.AllDay {
+			write("DTSTART;VALUE=DATE:" + event.Start.Format(icalDateLayout))
+			write("DTEND;VALUE=DATE:" + event.Start.AddDate(0, 0, 1).Format(icalDateLayout))
+		} else {
+			duration := event.Duration
+			if duration <= 0 {
+				duration = time.Hour
+			}
+			write("DTSTART:" + event.Start.UTC().Format(icalDateTimeLayout))
+			write("DTEND:" + event.Start.Add(duration).UTC().Format(icalDateTimeLayout))
+		}
+		write("SUMMARY:" + EscapeICalText(event.Summary))
+		if event.Description != "" {
+			write("DESCRIPTION:" + EscapeICalText(event.Description))
+		}
+		if event.Location != "" {
+			write("LOCATION:" + EscapeICalText(event.Location))
+		}
+		if event.Status != "" {
+			write("STATUS:" + event.Status)
+		}
+		if !event.LastModified.IsZero() {
+			write("LAST-MODIFIED:" + event.LastModified.UTC().Format(icalDateTimeLayout))
+		}
+		if event.Sequence > 0 {
+			write("SEQUENCE:" + strconv.FormatInt(event.Sequence, 10))
+		}
+		write("END:VEVENT")
+	}
+
+	write("END:VCALENDAR")
+	return b.String()
+}
+
+// EscapeICalText escapes a TEXT property value.
+func EscapeICalText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(value)
+}
+
+// foldICalLine splits lines longer than 75 octets, continuing them with a
+// single leading space, without cutting a UTF-8 sequence in half.
+func foldICalLine(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
+
+// ICalParsedEvent is a VEVENT read by ParseICalendar. End is zero when the
+// event has no DTEND.
+type ICalParsedEvent struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	End     time.Time
+	AllDay  bool
+	RRule   string
+}
+
+// ParseICalendar reads the VEVENTs of an iCalendar stream. Events without a
+// usable DTSTART are skipped.
+func ParseICalendar(r io.Reader) ([]ICalParsedEvent, error) {
+	lines, err := unfoldICalLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []ICalParsedEvent
+	var current *ICalParsedEvent
+	valid := false
+	for _, line := range lines {
+		name, params, value := splitICalProperty(line)
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VCALENDAR"):
+			valid = true
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			current = &ICalParsedEvent{}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if current != nil && !current.Start.IsZero() {
+				events = append(events, *current)
+			}
+			current = nil
+		case current == nil:
+			continue
+		case name == "UID":
+			current.UID = value
+		case name == "SUMMARY":
+			current.Summary = unescapeICalText(value)
+		case name == "RRULE":
+			current.RRule = value
+		case name == "DTSTART":
+			current.Start, current.AllDay = parseICalTime(params, value)
+		case name == "DTEND":
+			current.End, _ = parseICalTime(params, value)
+		}
+	}
+
+	if !valid {
+		return nil, errors.New("invalid iCalendar file")
+	}
+	return events, nil
+}
+
+func unfoldICalLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// splitICalProperty splits a content line into its upper-cased name, its
+// parameters and its value. Colons inside quoted parameter values do not end
+// the name.
+func splitICalProperty(line string) (string, map[string]string, string) {
+	quoted := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return strings.ToUpper(line), nil, ""
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	params := map[string]string{}
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return strings.ToUpper(parts[0]), params, line[colon+1:]
+}
+
+func parseICalTime(params map[string]string, value string) (time.Time, bool) {
+	if params["VALUE"] == "DATE" || len(value) == len(icalDateLayout) {
+		t, err := time.ParseInLocation(icalDateLayout, value, time.UTC)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(icalDateTimeLayout, value)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, false
+	}
+
+	loc := time.UTC
+	if tzid := params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, false
+}
+
+func unescapeICalText(value string) string {
+	replacer := strings.NewReplacer(
+		`\\`, `\`,
+		`\;`, ";",
+		`\,`, ",",
+		`\n`, "\n",
+		`\N`, "\n",
+	)
+	return replacer.Replace(value)
+}
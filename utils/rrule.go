@@ -0,0 +1,532 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RRule is a parsed RFC 5545 recurrence rule. It supports the DAILY, WEEKLY,
+// MONTHLY and YEARLY frequencies with the INTERVAL, COUNT, UNTIL, BYDAY,
+// BYMONTHDAY, BYMONTH and BYSETPOS parts, which covers rules such as "first
+// Monday of each month" (FREQ=MONTHLY;BYDAY=1MO) or "every quarter on the
+// 15th" (FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=15). Weeks always start on Monday.
+type RRule struct {
+	Freq       string
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []RRuleWeekday
+	ByMonthDay []int
+	ByMonth    []int
+	BySetPos   []int
+}
+
+// RRuleWeekday is a BYDAY entry. N is the optional ordinal, e.g. 1 for "1MO"
+// or -1 for "-1FR"; zero means every such weekday in the period.
+type RRuleWeekday struct {
+	Weekday time.Weekday
+	N       int
+}
+
+const (
+	RRuleFreqDaily   = "DAILY"
+	RRuleFreqWeekly  = "WEEKLY"
+	RRuleFreqMonthly = "MONTHLY"
+	RRuleFreqYearly  = "YEARLY"
+)
+
+// maxRRulePeriods bounds how many periods are scanned past the requested date
+// so rules that can never match (e.g. BYMONTHDAY=31;BYMONTH=2) terminate.
+const maxRRulePeriods = 10000
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// ParseRRule parses the value of an RRULE property, with or without the
+// leading "RRULE:".
+func ParseRRule(value string) (*RRule, error) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "RRULE:"), "rrule:")
+	if value == "" {
+		return nil, errors.New("recurrence rule is empty")
+	}
+
+	rule := &RRule{Interval: 1}
+	seen := map[string]bool{}
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		key = strings.ToUpper(strings.TrimSpace(key))
+		val = strings.ToUpper(strings.TrimSpace(val))
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate rule part %s", key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			switch val {
+			case RRuleFreqDaily, RRuleFreqWeekly, RRuleFreqMonthly, RRuleFreqYearly:
+				rule.Freq = val
+			default:
+				err = fmt.Errorf("unsupported FREQ %s", val)
+			}
+		case "INTERVAL":
+			rule.Interval, err = parseRRuleInt(val, 1, 1000)
+		case "COUNT":
+			rule.Count, err = parseRRuleInt(val, 1, 10000)
+		case "UNTIL":
+			var until time.Time
+			until, err = parseRRuleUntil(val)
+			rule.Until = &until
+		case "BYDAY":
+			rule.ByDay, err = parseRRuleByDay(val)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseRRuleIntList(val, 1, 31, true)
+		case "BYMONTH":
+			rule.ByMonth, err = parseRRuleIntList(val, 1, 12, false)
+		case "BYSETPOS":
+			rule.BySetPos, err = parseRRuleIntList(val, 1, 366, true)
+		case "WKST":
+			if _, ok := rruleWeekdays[val]; !ok {
+				err = fmt.Errorf("invalid WKST %s", val)
+			}
+		default:
+			err = fmt.Errorf("unsupported rule part %s", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, errors.New("FREQ is required")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, errors.New("COUNT and UNTIL cannot both be set")
+	}
+	if rule.Freq == RRuleFreqDaily || rule.Freq == RRuleFreqWeekly {
+		for _, day := range rule.ByDay {
+			if day.N != 0 {
+				return nil, fmt.Errorf("BYDAY ordinals are not allowed with FREQ=%s", rule.Freq)
+			}
+		}
+	}
+	if rule.Freq == RRuleFreqWeekly && len(rule.ByMonthDay) > 0 {
+		return nil, errors.New("BYMONTHDAY is not allowed with FREQ=WEEKLY")
+	}
+
+	return rule, nil
+}
+
+func parseRRuleInt(val string, min, max int) (int, error) {
+	n, err := strconv.Atoi(val)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("value %s must be between %d and %d", val, min, max)
+	}
+	return n, nil
+}
+
+func parseRRuleIntList(val string, min, max int, allowNegative bool) ([]int, error) {
+	var values []int
+	for _, item := range strings.Split(val, ",") {
+		n, err := strconv.Atoi(item)
+		abs := n
+		if abs < 0 && allowNegative {
+			abs = -abs
+		}
+		if err != nil || abs < min || abs > max {
+			return nil, fmt.Errorf("invalid value %s", item)
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+func parseRRuleByDay(val string) ([]RRuleWeekday, error) {
+	var days []RRuleWeekday
+	for _, item := range strings.Split(val, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY value %s", item)
+		}
+		weekday, ok := rruleWeekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY value %s", item)
+		}
+		day := RRuleWeekday{Weekday: weekday}
+		if ordinal := item[:len(item)-2]; ordinal != "" {
+			n, err := strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid BYDAY value %s", item)
+			}
+			day.N = n
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func parseRRuleUntil(val string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, val); err == nil {
+			if layout == "20060102" {
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %s", val)
+}
+
+// Iterate calls fn with each occurrence of the rule starting at dtstart, in
+// chronological order, until fn returns false or the rule is exhausted.
+// Occurrences keep the time of day and location of dtstart.
+func (r *RRule) Iterate(dtstart time.Time, fn func(time.Time) bool) {
+	r.IterateFrom(dtstart, dtstart, fn)
+}
+
+// IterateFrom is Iterate limited to the occurrences at or after from. Periods
+// before the one containing from are skipped rather than expanded, so a rule
+// that has been running for years costs the same as a new one. COUNT rules
+// are still expanded from dtstart, as earlier occurrences count towards the
+// limit.
+func (r *RRule) IterateFrom(dtstart, from time.Time, fn func(time.Time) bool) {
+	loc := dtstart.Location()
+	hour, minute, second := dtstart.Clock()
+	startDay := dateOf(dtstart)
+	emitted := 0
+
+	first := 0
+	if r.Count == 0 {
+		// One period early, as from may fall on another date in dtstart's
+		// location.
+		first = r.periodOf(startDay, dateOf(from.In(loc))) - 1
+		if first < 0 {
+			first = 0
+		}
+	}
+
+	for period := first; period < first+maxRRulePeriods; period++ {
+		for _, day := range r.periodCandidates(startDay, period) {
+			occurrence := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, dtstart.Nanosecond(), loc)
+			if occurrence.Before(dtstart) {
+				continue
+			}
+			if r.Until != nil && occurrence.After(*r.Until) {
+				return
+			}
+			emitted++
+			if !occurrence.Before(from) && !fn(occurrence) {
+				return
+			}
+			if r.Count > 0 && emitted >= r.Count {
+				return
+			}
+		}
+	}
+}
+
+// After returns the first occurrence strictly after t.
+func (r *RRule) After(dtstart, t time.Time) (time.Time, bool) {
+	var next time.Time
+	found := false
+	r.IterateFrom(dtstart, t, func(occurrence time.Time) bool {
+		if occurrence.After(t) {
+			next = occurrence
+			found = true
+			return false
+		}
+		return true
+	})
+	return next, found
+}
+
+// Next returns up to n occurrences at or after from.
+func (r *RRule) Next(dtstart, from time.Time, n int) []time.Time {
+	var occurrences []time.Time
+	if n < 1 {
+		return occurrences
+	}
+	r.IterateFrom(dtstart, from, func(occurrence time.Time) bool {
+		occurrences = append(occurrences, occurrence)
+		return len(occurrences) < n
+	})
+	return occurrences
+}
+
+// Between returns the occurrences within [from, to].
+func (r *RRule) Between(dtstart, from, to time.Time) []time.Time {
+	var occurrences []time.Time
+	r.IterateFrom(dtstart, from, func(occurrence time.Time) bool {
+		if occurrence.After(to) {
+			return false
+		}
+		occurrences = append(occurrences, occurrence)
+		return true
+	})
+	return occurrences
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// periodOf returns the index of the period counted from start that contains
+// day, or a negative index if day is before start.
+func (r *RRule) periodOf(start, day time.Time) int {
+	var elapsed int
+	switch r.Freq {
+	case RRuleFreqDaily:
+		elapsed = int(day.Sub(start).Hours() / 24)
+	case RRuleFreqWeekly:
+		weekStart := start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+		if day.Before(weekStart) {
+			return -1
+		}
+		elapsed = int(day.Sub(weekStart).Hours()/24) / 7
+	case RRuleFreqMonthly:
+		elapsed = (day.Year()-start.Year())*12 + int(day.Month()) - int(start.Month())
+	case RRuleFreqYearly:
+		elapsed = day.Year() - start.Year()
+	}
+	if elapsed < 0 {
+		return -1
+	}
+	return elapsed / r.Interval
+}
+
+// periodCandidates returns the sorted days of the given period (the n-th
+// DAILY/WEEKLY/MONTHLY/YEARLY period counted from start) matched by the rule.
+func (r *RRule) periodCandidates(start time.Time, n int) []time.Time {
+	var days []time.Time
+	step := n * r.Interval
+
+	switch r.Freq {
+	case RRuleFreqDaily:
+		day := start.AddDate(0, 0, step)
+		if r.matchesMonth(day) && r.matchesMonthDay(day) && r.matchesWeekday(day) {
+			days = append(days, day)
+		}
+	case RRuleFreqWeekly:
+		weekStart := start.AddDate(0, 0, -((int(start.Weekday())+6)%7)+7*step)
+		for i := 0; i < 7; i++ {
+			day := weekStart.AddDate(0, 0, i)
+			if !r.matchesMonth(day) {
+				continue
+			}
+			if len(r.ByDay) == 0 && day.Weekday() != start.Weekday() {
+				continue
+			}
+			if len(r.ByDay) > 0 && !r.matchesWeekday(day) {
+				continue
+			}
+			days = append(days, day)
+		}
+	case RRuleFreqMonthly:
+		month := time.Date(start.Year(), start.Month()+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		if r.matchesMonth(month) {
+			days = r.monthCandidates(month, start)
+		}
+	case RRuleFreqYearly:
+		year := start.Year() + step
+		days = r.yearCandidates(year, start)
+	}
+
+	return r.applySetPos(days)
+}
+
+func (r *RRule) monthCandidates(month, start time.Time) []time.Time {
+	daysInMonth := month.AddDate(0, 1, -1).Day()
+
+	var byMonthDay map[int]bool
+	if len(r.ByMonthDay) > 0 {
+		byMonthDay = map[int]bool{}
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = daysInMonth + d + 1
+			}
+			if d >= 1 && d <= daysInMonth {
+				byMonthDay[d] = true
+			}
+		}
+	}
+
+	var byDay map[int]bool
+	if len(r.ByDay) > 0 {
+		byDay = map[int]bool{}
+		for _, entry := range r.ByDay {
+			var matches []int
+			for d := 1; d <= daysInMonth; d++ {
+				if month.AddDate(0, 0, d-1).Weekday() == entry.Weekday {
+					matches = append(matches, d)
+				}
+			}
+			for _, d := range pickOrdinal(matches, entry.N) {
+				byDay[d] = true
+			}
+		}
+	}
+
+	var days []time.Time
+	for d := 1; d <= daysInMonth; d++ {
+		selected := false
+		switch {
+		case byMonthDay != nil && byDay != nil:
+			selected = byMonthDay[d] && byDay[d]
+		case byMonthDay != nil:
+			selected = byMonthDay[d]
+		case byDay != nil:
+			selected = byDay[d]
+		default:
+			selected = d == start.Day()
+		}
+		if selected {
+			days = append(days, month.AddDate(0, 0, d-1))
+		}
+	}
+	return days
+}
+
+func (r *RRule) yearCandidates(year int, start time.Time) []time.Time {
+	if len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0 && len(r.ByDay) > 0 {
+		// BYDAY ordinals count within the whole year when no month is given.
+		first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		daysInYear := first.AddDate(1, 0, 0).Sub(first).Hours() / 24
+		selected := map[int]bool{}
+		for _, entry := range r.ByDay {
+			var matches []int
+			for d := 0; d < int(daysInYear); d++ {
+				if first.AddDate(0, 0, d).Weekday() == entry.Weekday {
+					matches = append(matches, d)
+				}
+			}
+			for _, d := range pickOrdinal(matches, entry.N) {
+				selected[d] = true
+			}
+		}
+		var days []time.Time
+		for d := 0; d < int(daysInYear); d++ {
+			if selected[d] {
+				days = append(days, first.AddDate(0, 0, d))
+			}
+		}
+		return days
+	}
+
+	months := r.ByMonth
+	if len(months) == 0 {
+		if len(r.ByMonthDay) > 0 {
+			months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+		} else {
+			months = []int{int(start.Month())}
+		}
+	}
+
+	var days []time.Time
+	for m := 1; m <= 12; m++ {
+		if !containsInt(months, m) {
+			continue
+		}
+		month := time.Date(year, time.Month(m), 1, 0, 0, 0, 0, time.UTC)
+		days = append(days, r.monthCandidates(month, start)...)
+	}
+	return days
+}
+
+func (r *RRule) applySetPos(days []time.Time) []time.Time {
+	if len(r.BySetPos) == 0 || len(days) == 0 {
+		return days
+	}
+
+	var selected []time.Time
+	for _, pos := range r.BySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(days) + pos
+		}
+		if i >= 0 && i < len(days) {
+			selected = append(selected, days[i])
+		}
+	}
+	sort.Slice(selected, func(a, b int) bool { return selected[a].Before(selected[b]) })
+
+	unique := selected[:0]
+	for i, day := range selected {
+		if i == 0 || !day.Equal(selected[i-1]) {
+			unique = append(unique, day)
+		}
+	}
+	return unique
+}
+
+func (r *RRule) matchesMonth(day time.Time) bool {
+	return len(r.ByMonth) == 0 || containsInt(r.ByMonth, int(day.Month()))
+}
+
+func (r *RRule) matchesMonthDay(day time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, d := range r.ByMonthDay {
+		if d < 0 {
+			d = daysInMonth + d + 1
+		}
+		if d == day.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RRule) matchesWeekday(day time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, entry := range r.ByDay {
+		if entry.Weekday == day.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// pickOrdinal selects the n-th element of values (negative counts from the
+// end); zero selects them all.
+func pickOrdinal(values []int, n int) []int {
+	if n == 0 {
+		return values
+	}
+	i := n - 1
+	if n < 0 {
+		i = len(values) + n
+	}
+	if i < 0 || i >= len(values) {
+		return nil
+	}
+	return []int{values[i]}
+}
+
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
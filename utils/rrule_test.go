@@ -0,0 +1,259 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func rruleDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+}
+
+func TestRRuleNext(t *testing.T) {
+	// A Wednesday.
+	dtstart := rruleDate(2025, time.January, 1)
+
+	tests := []struct {
+		name string
+		rule string
+		n    int
+		want []time.Time
+	}{
+		{
+			name: "first Monday of the month",
+			rule: "FREQ=MONTHLY;BYDAY=1MO",
+			n:    4,
+			want: []time.Time{
+				rruleDate(2025, time.January, 6),
+				rruleDate(2025, time.February, 3),
+				rruleDate(2025, time.March, 3),
+				rruleDate(2025, time.April, 7),
+			},
+		},
+		{
+			name: "quarterly on the 15th",
+			rule: "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=15",
+			n:    4,
+			want: []time.Time{
+				rruleDate(2025, time.January, 15),
+				rruleDate(2025, time.April, 15),
+				rruleDate(2025, time.July, 15),
+				rruleDate(2025, time.October, 15),
+			},
+		},
+		{
+			name: "weekdays only",
+			rule: "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+			n:    6,
+			want: []time.Time{
+				rruleDate(2025, time.January, 1),
+				rruleDate(2025, time.January, 2),
+				rruleDate(2025, time.January, 3),
+				rruleDate(2025, time.January, 6),
+				rruleDate(2025, time.January, 7),
+				rruleDate(2025, time.January, 8),
+			},
+		},
+		{
+			name: "daily weekdays only",
+			rule: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			n:    4,
+			want: []time.Time{
+				rruleDate(2025, time.January, 1),
+				rruleDate(2025, time.January, 2),
+				rruleDate(2025, time.January, 3),
+				rruleDate(2025, time.January, 6),
+			},
+		},
+		{
+			name: "COUNT limits the occurrences",
+			rule: "FREQ=DAILY;COUNT=3",
+			n:    10,
+			want: []time.Time{
+				rruleDate(2025, time.January, 1),
+				rruleDate(2025, time.January, 2),
+				rruleDate(2025, time.January, 3),
+			},
+		},
+		{
+			name: "UNTIL date includes that whole day",
+			rule: "FREQ=WEEKLY;UNTIL=20250122",
+			n:    10,
+			want: []time.Time{
+				rruleDate(2025, time.January, 1),
+				rruleDate(2025, time.January, 8),
+				rruleDate(2025, time.January, 15),
+				rruleDate(2025, time.January, 22),
+			},
+		},
+		{
+			name: "UNTIL date-time before the time of day",
+			rule: "FREQ=WEEKLY;UNTIL=20250122T080000Z",
+			n:    10,
+			want: []time.Time{
+				rruleDate(2025, time.January, 1),
+				rruleDate(2025, time.January, 8),
+				rruleDate(2025, time.January, 15),
+			},
+		},
+		{
+			name: "BYSETPOS=-1 picks the last weekday of the month",
+			rule: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			n:    3,
+			want: []time.Time{
+				rruleDate(2025, time.January, 31),
+				rruleDate(2025, time.February, 28),
+				rruleDate(2025, time.March, 31),
+			},
+		},
+		{
+			name: "last Friday of the month",
+			rule: "RRULE:FREQ=MONTHLY;BYDAY=-1FR",
+			n:    3,
+			want: []time.Time{
+				rruleDate(2025, time.January, 31),
+				rruleDate(2025, time.February, 28),
+				rruleDate(2025, time.March, 28),
+			},
+		},
+		{
+			name: "yearly in March and September",
+			rule: "FREQ=YEARLY;BYMONTH=3,9;BYMONTHDAY=1",
+			n:    3,
+			want: []time.Time{
+				rruleDate(2025, time.March, 1),
+				rruleDate(2025, time.September, 1),
+				rruleDate(2026, time.March, 1),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRRule(%q) failed: %v", tt.rule, err)
+			}
+			got := rule.Next(dtstart, dtstart, tt.n)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d occurrences %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRRuleAfter(t *testing.T) {
+	rule, err := ParseRRule("FREQ=MONTHLY;BYDAY=1MO")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dtstart := rruleDate(2025, time.January, 1)
+
+	got, ok := rule.After(dtstart, rruleDate(2025, time.February, 3))
+	if !ok || !got.Equal(rruleDate(2025, time.March, 3)) {
+		t.Errorf("After = %s, %v, want %s", got, ok, rruleDate(2025, time.March, 3))
+	}
+
+	limited, err := ParseRRule("FREQ=MONTHLY;BYDAY=1MO;COUNT=2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := limited.After(dtstart, rruleDate(2025, time.February, 3)); ok {
+		t.Errorf("After past the last occurrence = %s, want none", got)
+	}
+}
+
+func TestRRuleBetween(t *testing.T) {
+	rule, err := ParseRRule("FREQ=WEEKLY;INTERVAL=2;BYDAY=MO")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dtstart := rruleDate(2025, time.January, 6)
+
+	got := rule.Between(dtstart, rruleDate(2025, time.January, 10), rruleDate(2025, time.February, 17))
+	want := []time.Time{
+		rruleDate(2025, time.January, 20),
+		rruleDate(2025, time.February, 3),
+		rruleDate(2025, time.February, 17),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range got {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("occurrence %d = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRRuleExpandsLongRunningRules(t *testing.T) {
+	// More than maxRRulePeriods days after dtstart.
+	dtstart := rruleDate(1990, time.January, 1)
+	from := rruleDate(2025, time.March, 5)
+
+	daily, err := ParseRRule("FREQ=DAILY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := daily.After(dtstart, from); !ok || !got.Equal(rruleDate(2025, time.March, 6)) {
+		t.Errorf("After = %s, %v, want %s", got, ok, rruleDate(2025, time.March, 6))
+	}
+	if got := daily.Between(dtstart, from, rruleDate(2025, time.March, 7)); len(got) != 3 {
+		t.Errorf("Between = %v, want 3 occurrences", got)
+	}
+
+	weekly, err := ParseRRule("FREQ=WEEKLY;INTERVAL=3;BYDAY=MO,TH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := weekly.Next(dtstart, from, 2)
+	if len(got) != 2 {
+		t.Fatalf("Next = %v, want 2 occurrences", got)
+	}
+	// The series must stay on its own weeks, every third one from dtstart.
+	for _, occurrence := range got {
+		weeks := int(dateOf(occurrence).Sub(dateOf(dtstart)).Hours()/24) / 7
+		if weeks%3 != 0 || occurrence.Before(from) {
+			t.Errorf("occurrence %s is not on the rule's weeks at or after %s", occurrence, from)
+		}
+	}
+}
+
+func TestParseRRuleRejectsInvalidRules(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+	}{
+		{"empty", ""},
+		{"missing FREQ", "INTERVAL=2"},
+		{"unsupported FREQ", "FREQ=HOURLY"},
+		{"part without value", "FREQ=DAILY;COUNT"},
+		{"duplicate part", "FREQ=DAILY;FREQ=WEEKLY"},
+		{"unsupported part", "FREQ=DAILY;BYHOUR=9"},
+		{"zero INTERVAL", "FREQ=DAILY;INTERVAL=0"},
+		{"zero COUNT", "FREQ=DAILY;COUNT=0"},
+		{"COUNT and UNTIL", "FREQ=DAILY;COUNT=2;UNTIL=20250101"},
+		{"invalid UNTIL", "FREQ=DAILY;UNTIL=2025-01-01"},
+		{"invalid weekday", "FREQ=MONTHLY;BYDAY=XX"},
+		{"zero ordinal", "FREQ=MONTHLY;BYDAY=0MO"},
+		{"ordinal with FREQ=WEEKLY", "FREQ=WEEKLY;BYDAY=1MO"},
+		{"BYMONTHDAY out of range", "FREQ=MONTHLY;BYMONTHDAY=32"},
+		{"BYMONTHDAY with FREQ=WEEKLY", "FREQ=WEEKLY;BYMONTHDAY=1"},
+		{"negative BYMONTH", "FREQ=YEARLY;BYMONTH=-1"},
+		{"BYSETPOS of zero", "FREQ=MONTHLY;BYDAY=MO;BYSETPOS=0"},
+		{"invalid WKST", "FREQ=WEEKLY;WKST=XX"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseRRule(tt.rule); err == nil {
+				t.Errorf("ParseRRule(%q) succeeded, want an error", tt.rule)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+)
+
+// maxThumbnailSourcePixels guards against images that are small on disk but
+// huge once decoded.
+const maxThumbnailSourcePixels = 50_000_000
+
+// MakeThumbnail decodes a JPEG, PNG or GIF image and returns a JPEG scaled
+// down to fit within size×size pixels. Smaller images keep their dimensions.
+func MakeThumbnail(data []byte, size int) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxThumbnailSourcePixels {
+		return nil, errors.New("image dimensions are not supported")
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	width, height := config.Width, config.Height
+	if width > size || height > size {
+		if width >= height {
+			height = max(1, height*size/width)
+			width = size
+		} else {
+			width = max(1, width*size/height)
+			height = size
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, scaleImage(src, width, height), &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// scaleImage resizes src to width×height by averaging the source pixels that
+// fall into each target pixel. Transparent areas are flattened onto white.
+func scaleImage(src image.Image, width, height int) image.Image {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+
+			var r, g, b, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					// Composite over white; the channels are premultiplied.
+					r += uint64(cr + 0xffff - ca)
+					g += uint64(cg + 0xffff - ca)
+					b += uint64(cb + 0xffff - ca)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: 0xffff,
+			})
+		}
+	}
+	return dst
+}